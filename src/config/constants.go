@@ -16,6 +16,8 @@
 package config
 
 import (
+	"time"
+
 	"projectred-rpg.com/game/types"
 )
 
@@ -53,6 +55,105 @@ func IsOuterWall(mapX, mapY, mapWidth, mapHeight int) bool {
 	return mapX == 0 || mapX == mapWidth-1 || mapY == 0 || mapY == mapHeight-1
 }
 
+// Display
+const (
+	// MinScreenWidth is the narrowest terminal the game renders in, smaller ones get a resize notice
+	MinScreenWidth = 80
+	// MinScreenHeight is the shortest terminal the game renders in
+	MinScreenHeight = 24
+)
+
+// Save files
+const (
+	// SaveDir is the directory, relative to the working directory, holding the save slots
+	SaveDir = "saves"
+	// SaveSlots is the number of save slots offered in the save and load menus
+	SaveSlots = 3
+)
+
+// Economy
+const (
+	// SellBackPercent is the share of the price paid that merchants give back when buying an item
+	SellBackPercent = 50
+)
+
+// Combat
+const (
+	// BaseHitChance is the percent chance to land an attack when accuracy equals the target's speed
+	BaseHitChance = 80
+	// HitChancePerPoint is the hit chance gained for each point of accuracy above the target's speed
+	HitChancePerPoint = 2
+	// MinHitChance and MaxHitChance bound the hit chance of every attack
+	MinHitChance = 20
+	MaxHitChance = 95
+	// BaseCritChance is the percent chance of a critical hit, raised by accuracy
+	BaseCritChance = 5
+	// CritChancePerAccuracy is the accuracy needed for each extra percent of critical chance
+	CritChancePerAccuracy = 5
+	// MaxCritChance caps the critical chance
+	MaxCritChance = 30
+	// CritDamagePercent is the damage of a critical hit in percent of a normal hit
+	CritDamagePercent = 150
+	// DamageVariancePercent is how far damage can stray from its base, in percent both ways
+	DamageVariancePercent = 10
+	// SpecialAttackAccuracyMalus is the accuracy enemies lose on their special attacks
+	SpecialAttackAccuracyMalus = 15
+	// EngageRange is how close the player must come to an enemy to start a fight
+	EngageRange = 3.0
+	// AggroRadius is the distance from the player within which enemies join a fight
+	AggroRadius = 12.0
+	// DefendDefenseBonus is the defense a defensive stance adds until the next hit taken
+	DefendDefenseBonus = 4
+	// TauntAccuracyMalus is the accuracy a taunted combatant loses for TauntTurns of its turns
+	TauntAccuracyMalus = 10
+	TauntTurns         = 2
+)
+
+// Movement
+const (
+	// SpeedPerSlowStep is the effective speed needed to ignore one extra move input on slowing tiles
+	SpeedPerSlowStep = 30
+)
+
+// Exploration AI
+const (
+	// EnemyStepInterval is the time between two moves of chasing enemies
+	EnemyStepInterval = 200 * time.Millisecond
+	// PatrolStepEvery is the number of enemy steps a patrolling or returning enemy waits between two moves
+	PatrolStepEvery = 2
+	// DefaultEnemySight is the distance at which enemies without a sight of their own notice the player
+	DefaultEnemySight = 10
+	// ChaseLeashPercent is how far a chasing enemy follows the player, in percent of its sight
+	ChaseLeashPercent = 150
+	// EnemyRestSteps is the number of enemy steps during which enemies ignore the player after a fight
+	EnemyRestSteps = 15
+	// PathSearchLimit caps the tiles explored when looking for a path
+	PathSearchLimit = 3000
+)
+
+// Dialogues
+const (
+	// DialogueTypeInterval is the time between two letters typed in the dialogue box
+	DialogueTypeInterval = 20 * time.Millisecond
+)
+
+// StartingItem is a consumable stack every new character carries
+type StartingItem struct {
+	Key      string // Consumable key in assets/data/consumables
+	Quantity int
+}
+
+// GetStartingItems returns the consumables given to a new character
+func GetStartingItems() []StartingItem {
+	return []StartingItem{
+		{Key: "small_medkit", Quantity: 2},
+		{Key: "flash", Quantity: 1},
+	}
+}
+
+// StartingWeapon is the weapon key every new character has equipped
+const StartingWeapon = "iron-sword"
+
 // Default classes available in the game
 func GetDefaultClasses() []types.Class {
 	return []types.Class{
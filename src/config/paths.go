@@ -4,30 +4,40 @@ import "path/filepath"
 
 // AssetPaths contains all asset directory paths
 type AssetPaths struct {
-	Root          string
-	DataDir       string
-	AnimationsDir string
-	InterfaceDir  string
-	LevelsDir     string
-	WorldsDir     string
-	WeaponsDir    string
-	EnemiesDir    string
-	ClassesDir    string
+	Root           string
+	DataDir        string
+	AnimationsDir  string
+	InterfaceDir   string
+	LevelsDir      string
+	WorldsDir      string
+	WeaponsDir     string
+	ConsumablesDir string
+	MerchantsDir   string
+	ImplantsDir    string
+	EnemiesDir     string
+	DialoguesDir   string
+	QuestsDir      string
+	ClassesDir     string
 }
 
 // DefaultAssetPaths returns the default asset path configuration
 func DefaultAssetPaths() AssetPaths {
 	root := "assets"
 	return AssetPaths{
-		Root:          root,
-		DataDir:       filepath.Join(root, "data"),
-		AnimationsDir: filepath.Join(root, "animations"),
-		InterfaceDir:  filepath.Join(root, "interface"),
-		LevelsDir:     filepath.Join(root, "levels"),
-		WorldsDir:     filepath.Join(root, "levels"),
-		WeaponsDir:    filepath.Join(root, "data"),
-		EnemiesDir:    filepath.Join(root, "data"),
-		ClassesDir:    filepath.Join(root, "data"),
+		Root:           root,
+		DataDir:        filepath.Join(root, "data"),
+		AnimationsDir:  filepath.Join(root, "animations"),
+		InterfaceDir:   filepath.Join(root, "interface"),
+		LevelsDir:      filepath.Join(root, "levels"),
+		WorldsDir:      filepath.Join(root, "levels"),
+		WeaponsDir:     filepath.Join(root, "data", "weapons"),
+		ConsumablesDir: filepath.Join(root, "data", "consumables"),
+		MerchantsDir:   filepath.Join(root, "data", "merchants"),
+		ImplantsDir:    filepath.Join(root, "data", "implants"),
+		EnemiesDir:     filepath.Join(root, "data", "enemies"),
+		DialoguesDir:   filepath.Join(root, "data", "dialogues"),
+		QuestsDir:      filepath.Join(root, "data", "quests"),
+		ClassesDir:     filepath.Join(root, "data"),
 	}
 }
 
@@ -0,0 +1,233 @@
+package engine
+
+import (
+	"image/color"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// CellStyle is the look of a single screen cell.
+// Colors are "#RRGGBB" hex values or ANSI indexes "0" to "255"; empty means terminal default.
+type CellStyle struct {
+	Fg   string
+	Bg   string
+	Bold bool
+	Dim  bool
+}
+
+// IsZero reports whether the style is the terminal default
+func (s CellStyle) IsZero() bool {
+	return s == CellStyle{}
+}
+
+// Cell is a rune drawn with a style
+type Cell struct {
+	Rune  rune
+	Style CellStyle
+}
+
+// blankCell is the content of a cleared cell
+var blankCell = Cell{Rune: ' '}
+
+// CellBuffer is a fixed-size grid of styled cells that renders to text with minimal SGR sequences
+type CellBuffer struct {
+	width  int
+	height int
+	cells  []Cell
+}
+
+// NewCellBuffer creates a buffer of blank cells
+func NewCellBuffer(width, height int) *CellBuffer {
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	cb := &CellBuffer{
+		width:  width,
+		height: height,
+		cells:  make([]Cell, width*height),
+	}
+	cb.Clear()
+	return cb
+}
+
+// Width returns the number of columns
+func (cb *CellBuffer) Width() int { return cb.width }
+
+// Height returns the number of rows
+func (cb *CellBuffer) Height() int { return cb.height }
+
+// InBounds reports whether x,y is inside the buffer
+func (cb *CellBuffer) InBounds(x, y int) bool {
+	return x >= 0 && y >= 0 && x < cb.width && y < cb.height
+}
+
+// Clear resets every cell to a blank unstyled space
+func (cb *CellBuffer) Clear() {
+	for i := range cb.cells {
+		cb.cells[i] = blankCell
+	}
+}
+
+// Cell returns the cell at x,y, or a blank cell when out of bounds
+func (cb *CellBuffer) Cell(x, y int) Cell {
+	if !cb.InBounds(x, y) {
+		return blankCell
+	}
+	return cb.cells[y*cb.width+x]
+}
+
+// Set writes a rune and its style at x,y; out of bounds writes are ignored
+func (cb *CellBuffer) Set(x, y int, r rune, style CellStyle) {
+	if !cb.InBounds(x, y) {
+		return
+	}
+	cb.cells[y*cb.width+x] = Cell{Rune: r, Style: style}
+}
+
+// SetString writes s from x,y on a single row and returns the number of cells written
+func (cb *CellBuffer) SetString(x, y int, s string, style CellStyle) int {
+	n := 0
+	for _, r := range s {
+		cb.Set(x+n, y, r, style)
+		n++
+	}
+	return n
+}
+
+// Render returns the buffer as text colored for the global color profile
+func (cb *CellBuffer) Render() string {
+	return cb.RenderProfile(GetColorProfile())
+}
+
+// RenderProfile returns the buffer as text, downsampling colors to the given profile.
+// SGR sequences are only emitted when the style changes and every styled row ends with a reset.
+func (cb *CellBuffer) RenderProfile(profile colorprofile.Profile) string {
+	var sb strings.Builder
+	sb.Grow(cb.width * cb.height)
+
+	for y := 0; y < cb.height; y++ {
+		if y > 0 {
+			sb.WriteByte('\n')
+		}
+
+		current := CellStyle{}
+		for x := 0; x < cb.width; x++ {
+			cell := cb.cells[y*cb.width+x]
+			style := profileStyle(cell.Style, profile)
+			if style != current {
+				sb.WriteString(styleTransition(current, style, profile))
+				current = style
+			}
+			r := cell.Rune
+			if r == 0 {
+				r = ' '
+			}
+			sb.WriteRune(r)
+		}
+		if !current.IsZero() {
+			sb.WriteString(ansi.ResetStyle)
+		}
+	}
+	return sb.String()
+}
+
+// profileStyle drops the attributes a profile cannot display so equal looking cells compare equal
+func profileStyle(style CellStyle, profile colorprofile.Profile) CellStyle {
+	switch {
+	case profile == colorprofile.NoTTY:
+		return CellStyle{}
+	case profile == colorprofile.Ascii:
+		return CellStyle{Bold: style.Bold, Dim: style.Dim}
+	}
+	return style
+}
+
+// styleTransition returns the SGR sequence switching from one style to the next
+func styleTransition(from, to CellStyle, profile colorprofile.Profile) string {
+	if to.IsZero() {
+		return ansi.ResetStyle
+	}
+
+	var seq ansi.Style
+	// Bold and dim share the normal intensity reset, start over when one is turned off
+	if (from.Bold && !to.Bold) || (from.Dim && !to.Dim) {
+		seq = seq.Reset()
+		from = CellStyle{}
+	}
+
+	if to.Bold && !from.Bold {
+		seq = seq.Bold()
+	}
+	if to.Dim && !from.Dim {
+		seq = seq.Faint()
+	}
+	if to.Fg != from.Fg {
+		if c := profileColor(to.Fg, profile); c != nil {
+			seq = seq.ForegroundColor(c)
+		} else {
+			seq = seq.DefaultForegroundColor()
+		}
+	}
+	if to.Bg != from.Bg {
+		if c := profileColor(to.Bg, profile); c != nil {
+			seq = seq.BackgroundColor(c)
+		} else {
+			seq = seq.DefaultBackgroundColor()
+		}
+	}
+
+	if len(seq) == 0 {
+		return ""
+	}
+	return seq.String()
+}
+
+// profileColor parses a style color and converts it to the profile, nil for the default color
+func profileColor(value string, profile colorprofile.Profile) ansi.Color {
+	c := parseColor(value)
+	if c == nil {
+		return nil
+	}
+	converted := profile.Convert(c)
+	if converted == nil {
+		return nil
+	}
+	return converted
+}
+
+// parseColor reads "#RRGGBB", "#RGB" or an ANSI index, returning nil when invalid or empty
+func parseColor(value string) color.Color {
+	if value == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(value, "#") {
+		hex := value[1:]
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+		if len(hex) != 6 {
+			return nil
+		}
+		rgb, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return nil
+		}
+		return ansi.TrueColor(rgb)
+	}
+
+	index, err := strconv.Atoi(value)
+	if err != nil || index < 0 || index > 255 {
+		return nil
+	}
+	if index < 16 {
+		return ansi.BasicColor(index)
+	}
+	return ansi.IndexedColor(index)
+}
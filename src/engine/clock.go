@@ -0,0 +1,142 @@
+package engine
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock abstracts time so commands like Tick can run against a virtual clock
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+	// Sleep blocks until d has elapsed on this clock
+	Sleep(d time.Duration)
+}
+
+// realClock is the wall clock used by interactive programs
+type realClock struct{}
+
+// Now returns the wall clock time
+func (realClock) Now() time.Time { return time.Now() }
+
+// Sleep pauses the calling goroutine for d
+func (realClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+// RealClock returns the wall clock
+func RealClock() Clock {
+	return realClock{}
+}
+
+// sleeper is a goroutine parked on a VirtualClock until its deadline
+type sleeper struct {
+	deadline time.Time
+	wake     chan struct{}
+}
+
+// VirtualClock is a manually advanced clock for deterministic runs.
+// Sleep parks the caller until Advance moves time past its deadline.
+type VirtualClock struct {
+	mtx      sync.Mutex
+	now      time.Time
+	sleepers []*sleeper
+	onPark   func() // Notified whenever a goroutine starts sleeping
+}
+
+// NewVirtualClock creates a virtual clock starting at start
+func NewVirtualClock(start time.Time) *VirtualClock {
+	return &VirtualClock{now: start}
+}
+
+// Now returns the current virtual time
+func (c *VirtualClock) Now() time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.now
+}
+
+// Sleep parks the caller until the virtual time reaches now+d
+func (c *VirtualClock) Sleep(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+
+	c.mtx.Lock()
+	s := &sleeper{deadline: c.now.Add(d), wake: make(chan struct{})}
+	c.sleepers = append(c.sleepers, s)
+	onPark := c.onPark
+	c.mtx.Unlock()
+
+	if onPark != nil {
+		onPark()
+	}
+	<-s.wake
+}
+
+// Advance moves the virtual time forward by d and wakes every due sleeper
+func (c *VirtualClock) Advance(d time.Duration) {
+	target := c.Now().Add(d)
+	for s := c.popDue(target); s != nil; s = c.popDue(target) {
+		close(s.wake)
+	}
+	c.setNow(target)
+}
+
+// popDue removes the earliest sleeper due at or before target and moves time to its deadline.
+// Sleepers with equal deadlines are woken in the order they started sleeping.
+func (c *VirtualClock) popDue(target time.Time) *sleeper {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if len(c.sleepers) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(c.sleepers, func(i, j int) bool {
+		return c.sleepers[i].deadline.Before(c.sleepers[j].deadline)
+	})
+
+	next := c.sleepers[0]
+	if next.deadline.After(target) {
+		return nil
+	}
+
+	c.sleepers = c.sleepers[1:]
+	if next.deadline.After(c.now) {
+		c.now = next.deadline
+	}
+	return next
+}
+
+// nextDeadline returns the earliest sleeper deadline
+func (c *VirtualClock) nextDeadline() (time.Time, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if len(c.sleepers) == 0 {
+		return time.Time{}, false
+	}
+	earliest := c.sleepers[0].deadline
+	for _, s := range c.sleepers[1:] {
+		if s.deadline.Before(earliest) {
+			earliest = s.deadline
+		}
+	}
+	return earliest, true
+}
+
+// setNow moves the virtual time to t if it is later than the current time
+func (c *VirtualClock) setNow(t time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if t.After(c.now) {
+		c.now = t
+	}
+}
+
+// setParkHook installs the callback notified when a goroutine parks
+func (c *VirtualClock) setParkHook(hook func()) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.onPark = hook
+}
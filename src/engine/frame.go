@@ -0,0 +1,183 @@
+package engine
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// maxRunGap is the number of unchanged cells rewritten between two changed runs
+// instead of moving the cursor, a cursor move costs about as many bytes
+const maxRunGap = 4
+
+// frameCell is one terminal cell of a rendered frame
+type frameCell struct {
+	content string // Grapheme drawn in the cell, empty for the trailing half of a wide character
+	width   int
+	pen     string // SGR sequences active when the cell was drawn, empty for the default style
+}
+
+// blankFrameCell is the content of a cell nothing was drawn in
+var blankFrameCell = frameCell{content: " ", width: 1}
+
+// isContinuation reports whether the cell is covered by the wide character on its left
+func (c frameCell) isContinuation() bool {
+	return c.width == 0
+}
+
+// frame is a rendered view laid out as a matrix of terminal cells
+type frame struct {
+	width  int
+	height int
+	cells  []frameCell
+}
+
+// newFrame creates a frame of blank cells
+func newFrame(width, height int) *frame {
+	f := &frame{
+		width:  width,
+		height: height,
+		cells:  make([]frameCell, width*height),
+	}
+	for i := range f.cells {
+		f.cells[i] = blankFrameCell
+	}
+	return f
+}
+
+// parseFrame lays out view lines on a width x height cell matrix.
+// Content past the right edge or the last row is dropped, only SGR sequences are kept.
+func parseFrame(lines []string, width, height int) *frame {
+	f := newFrame(width, height)
+	for y, line := range lines {
+		if y >= height {
+			break
+		}
+		f.parseLine(y, line)
+	}
+	return f
+}
+
+// parseLine fills row y with the graphemes of a line and the style they are drawn with
+func (f *frame) parseLine(y int, line string) {
+	var state byte
+	pen := ""
+	x := 0
+
+	for len(line) > 0 && x < f.width {
+		seq, width, n, newState := ansi.DecodeSequence(line, state, nil)
+		state = newState
+		line = line[n:]
+
+		if width == 0 {
+			if isSGR(seq) {
+				pen = applySGR(pen, seq)
+			}
+			continue
+		}
+
+		row := f.cells[y*f.width : (y+1)*f.width]
+		if x+width > f.width {
+			// A wide character that does not fit is not drawn by the terminal either
+			break
+		}
+		row[x] = frameCell{content: seq, width: width, pen: pen}
+		for i := 1; i < width; i++ {
+			row[x+i] = frameCell{pen: pen}
+		}
+		x += width
+	}
+}
+
+// isSGR reports whether an escape sequence selects graphic rendition
+func isSGR(seq string) bool {
+	return strings.HasPrefix(seq, "\x1b[") && strings.HasSuffix(seq, "m")
+}
+
+// applySGR returns the pen after an SGR sequence, resets start a new pen
+func applySGR(pen, seq string) string {
+	params := seq[2 : len(seq)-1]
+	switch {
+	case params == "" || params == "0":
+		return ""
+	case strings.HasPrefix(params, "0;"):
+		return seq
+	}
+	return pen + seq
+}
+
+// row returns the cells of row y
+func (f *frame) row(y int) []frameCell {
+	return f.cells[y*f.width : (y+1)*f.width]
+}
+
+// diffFrames writes the cursor moves and cell runs turning prev into next on screen.
+// Rows listed in skip are left untouched. The pen is reset at the end of the output.
+func diffFrames(buf *bytes.Buffer, prev, next *frame, skip map[int]struct{}) {
+	pen := ""
+	penKnown := false
+
+	for y := 0; y < next.height; y++ {
+		if _, ignore := skip[y]; ignore {
+			continue
+		}
+		before, after := prev.row(y), next.row(y)
+
+		x := 0
+		for x < next.width {
+			if before[x] == after[x] {
+				x++
+				continue
+			}
+
+			// Wide characters are redrawn from their first column
+			start := x
+			if start > 0 && before[start].isContinuation() {
+				start--
+			}
+			for start > 0 && after[start].isContinuation() {
+				start--
+			}
+			end := runEnd(before, after, x+1)
+
+			buf.WriteString(ansi.CursorPosition(start+1, y+1))
+			for _, cell := range after[start:end] {
+				if cell.isContinuation() {
+					continue
+				}
+				if !penKnown || cell.pen != pen {
+					buf.WriteString(ansi.ResetStyle)
+					buf.WriteString(cell.pen)
+					pen = cell.pen
+					penKnown = true
+				}
+				buf.WriteString(cell.content)
+			}
+			x = end
+		}
+	}
+
+	if penKnown && pen != "" {
+		buf.WriteString(ansi.ResetStyle)
+	}
+}
+
+// runEnd returns the end of the changed run starting before from, swallowing short unchanged gaps
+func runEnd(before, after []frameCell, from int) int {
+	end := from
+	gap := 0
+	for x := from; x < len(after); x++ {
+		// The second half of a wide character belongs to the run that drew its first half
+		if before[x] != after[x] || (x == end && after[x].isContinuation()) {
+			end = x + 1
+			gap = 0
+			continue
+		}
+		gap++
+		if gap > maxRunGap {
+			break
+		}
+	}
+	return end
+}
@@ -1,10 +1,20 @@
 package engine
 
-import "sync"
+import (
+	"sync"
+
+	"github.com/charmbracelet/colorprofile"
+)
 
 var (
 	globalRenderer Renderer
 	rendererMutex  sync.RWMutex
+
+	globalClock Clock = realClock{}
+	clockMutex  sync.RWMutex
+
+	globalColorProfile = colorprofile.TrueColor
+	colorProfileMutex  sync.RWMutex
 )
 
 // SetGlobalRenderer sets the global renderer instance
@@ -20,3 +30,34 @@ func GetGlobalRenderer() Renderer {
 	defer rendererMutex.RUnlock()
 	return globalRenderer
 }
+
+// SetGlobalClock sets the clock used by time based commands such as Tick
+func SetGlobalClock(clock Clock) {
+	clockMutex.Lock()
+	defer clockMutex.Unlock()
+	if clock == nil {
+		clock = realClock{}
+	}
+	globalClock = clock
+}
+
+// GetGlobalClock returns the clock used by time based commands
+func GetGlobalClock() Clock {
+	clockMutex.RLock()
+	defer clockMutex.RUnlock()
+	return globalClock
+}
+
+// SetColorProfile sets the color profile used when rendering cell buffers
+func SetColorProfile(profile colorprofile.Profile) {
+	colorProfileMutex.Lock()
+	defer colorProfileMutex.Unlock()
+	globalColorProfile = profile
+}
+
+// GetColorProfile returns the color profile used when rendering cell buffers
+func GetColorProfile() colorprofile.Profile {
+	colorProfileMutex.RLock()
+	defer colorProfileMutex.RUnlock()
+	return globalColorProfile
+}
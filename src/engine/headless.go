@@ -0,0 +1,328 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/colorprofile"
+)
+
+// Default terminal size used by headless programs
+const (
+	HeadlessWidth  = 80
+	HeadlessHeight = 24
+)
+
+// scriptStep is either a message to deliver or a virtual time span to wait
+type scriptStep struct {
+	msg  Msg
+	wait time.Duration
+}
+
+// Script is a scripted input source for headless programs.
+// Steps run in order; every step is fully processed before the next one starts.
+type Script struct {
+	steps []scriptStep
+}
+
+// NewScript creates an empty input script
+func NewScript() *Script {
+	return &Script{}
+}
+
+// Send queues an arbitrary message
+func (s *Script) Send(msg Msg) *Script {
+	s.steps = append(s.steps, scriptStep{msg: msg})
+	return s
+}
+
+// Key queues a single key press
+func (s *Script) Key(r rune) *Script {
+	return s.Send(KeyFromRune(r))
+}
+
+// Keys queues one key press per rune of text
+func (s *Script) Keys(text string) *Script {
+	for _, r := range text {
+		s.Key(r)
+	}
+	return s
+}
+
+// Input queues raw terminal bytes, decoded like stdin
+func (s *Script) Input(data string) *Script {
+	for _, msg := range ParseKeys([]byte(data)) {
+		s.Send(msg)
+	}
+	return s
+}
+
+// Size queues a terminal resize
+func (s *Script) Size(width, height int) *Script {
+	return s.Send(SizeMsg{Width: width, Height: height})
+}
+
+// Wait advances the virtual clock by d, firing every timer that becomes due
+func (s *Script) Wait(d time.Duration) *Script {
+	s.steps = append(s.steps, scriptStep{wait: d})
+	return s
+}
+
+// MemoryRenderer is a Renderer that keeps every written frame in memory
+type MemoryRenderer struct {
+	mtx       sync.Mutex
+	frames    []string
+	stats     RenderStats
+	width     int
+	height    int
+	altScreen bool
+}
+
+// NewMemoryRenderer creates an in-memory renderer with the default headless size
+func NewMemoryRenderer() *MemoryRenderer {
+	return &MemoryRenderer{
+		width:  HeadlessWidth,
+		height: HeadlessHeight,
+	}
+}
+
+// Start is a no-op for the memory renderer
+func (r *MemoryRenderer) Start() {}
+
+// Stop is a no-op for the memory renderer
+func (r *MemoryRenderer) Stop() {}
+
+// Kill is a no-op for the memory renderer
+func (r *MemoryRenderer) Kill() {}
+
+// Write captures a frame
+func (r *MemoryRenderer) Write(s string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.frames = append(r.frames, s)
+	r.stats.Frames++
+	r.stats.BytesWritten += int64(len(s))
+	r.stats.LastFrameBytes = len(s)
+}
+
+// ClearScreen is a no-op for the memory renderer
+func (r *MemoryRenderer) ClearScreen() {}
+
+// Repaint is a no-op for the memory renderer
+func (r *MemoryRenderer) Repaint() {}
+
+// ShowCursor is a no-op for the memory renderer
+func (r *MemoryRenderer) ShowCursor() {}
+
+// HideCursor is a no-op for the memory renderer
+func (r *MemoryRenderer) HideCursor() {}
+
+// SetWindowTitle is a no-op for the memory renderer
+func (r *MemoryRenderer) SetWindowTitle(string) {}
+
+// AltScreen returns whether the alternate screen was requested
+func (r *MemoryRenderer) AltScreen() bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.altScreen
+}
+
+// EnterAltScreen records that the alternate screen was requested
+func (r *MemoryRenderer) EnterAltScreen() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.altScreen = true
+}
+
+// ExitAltScreen records that the alternate screen was left
+func (r *MemoryRenderer) ExitAltScreen() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.altScreen = false
+}
+
+// SetCursor is a no-op for the memory renderer
+func (r *MemoryRenderer) SetCursor(x, y int) {}
+
+// EnableBracketedPaste is a no-op for the memory renderer
+func (r *MemoryRenderer) EnableBracketedPaste() {}
+
+// DisableBracketedPaste is a no-op for the memory renderer
+func (r *MemoryRenderer) DisableBracketedPaste() {}
+
+// GetSize returns the simulated terminal size
+func (r *MemoryRenderer) GetSize() (int, int) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.width, r.height
+}
+
+// Resize changes the simulated terminal size
+func (r *MemoryRenderer) Resize(width, height int) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.width = width
+	r.height = height
+}
+
+// Stats returns the number and size of the captured frames, frame times stay zero
+func (r *MemoryRenderer) Stats() RenderStats {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.stats
+}
+
+// Frames returns a copy of every captured frame in order
+func (r *MemoryRenderer) Frames() []string {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	frames := make([]string, len(r.frames))
+	copy(frames, r.frames)
+	return frames
+}
+
+// LastFrame returns the most recent frame, or "" if nothing was rendered
+func (r *MemoryRenderer) LastFrame() string {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if len(r.frames) == 0 {
+		return ""
+	}
+	return r.frames[len(r.frames)-1]
+}
+
+// headlessEvent is reported by command goroutines to the headless loop
+type headlessEvent struct {
+	msg    Msg
+	parked bool // The goroutine is sleeping on the virtual clock instead of returning
+}
+
+// headlessLoop drives a model deterministically from a script and a virtual clock
+type headlessLoop struct {
+	p       *Program
+	events  chan headlessEvent
+	running int // Command goroutines neither finished nor parked
+	quit    bool
+}
+
+// exec runs cmd in a goroutine tracked by the loop
+func (h *headlessLoop) exec(cmd Cmd) {
+	if cmd == nil {
+		return
+	}
+	h.running++
+	go func() {
+		h.events <- headlessEvent{msg: cmd()}
+	}()
+}
+
+// deliver hands msg to the model, schedules its command and captures the frame
+func (h *headlessLoop) deliver(msg Msg) {
+	if h.quit {
+		return
+	}
+	if h.p.intercept(msg) {
+		h.quit = h.p.quit
+		return
+	}
+
+	var cmd Cmd
+	h.p.Model, cmd = h.p.Model.Update(msg)
+	h.exec(cmd)
+	h.p.renderer.Write(h.p.Model.View())
+}
+
+// settle processes command results until every goroutine has finished or parked
+func (h *headlessLoop) settle() {
+	for h.running > 0 {
+		ev := <-h.events
+		h.running--
+		if ev.parked {
+			continue
+		}
+		h.deliver(ev.msg)
+	}
+}
+
+// advance moves the clock forward, waking due sleepers and firing due timers one at a time
+func (h *headlessLoop) advance(d time.Duration) {
+	clock := h.p.clock
+	target := clock.Now().Add(d)
+	for !h.quit {
+		// Timers due before the next sleeper fire first
+		if deadline, ok := h.p.timers.nextDeadline(); ok && !deadline.After(target) {
+			if sleeperDeadline, ok := clock.nextDeadline(); !ok || deadline.Before(sleeperDeadline) {
+				clock.setNow(deadline)
+				msg, _ := h.p.timers.popDue(deadline)
+				h.deliver(msg)
+				h.settle()
+				continue
+			}
+		}
+
+		s := clock.popDue(target)
+		if s == nil {
+			break
+		}
+		h.running++
+		close(s.wake)
+		h.settle()
+	}
+	clock.setNow(target)
+}
+
+// runHeadless runs the program without a terminal, driven by its script
+func (p *Program) runHeadless() error {
+	h := &headlessLoop{
+		p:      p,
+		events: make(chan headlessEvent),
+	}
+
+	SetGlobalRenderer(p.renderer)
+	SetGlobalClock(p.clock)
+
+	// Frames keep full colors unless a profile is forced, whatever terminal runs the program
+	if p.colorProfile != nil {
+		SetColorProfile(*p.colorProfile)
+	} else {
+		SetColorProfile(colorprofile.TrueColor)
+	}
+	defer SetGlobalClock(realClock{})
+
+	p.clock.setParkHook(func() {
+		h.events <- headlessEvent{parked: true}
+	})
+	defer p.clock.setParkHook(nil)
+
+	p.renderer.Start()
+	defer p.renderer.Stop()
+
+	if p.useAltScreen {
+		p.renderer.EnterAltScreen()
+		defer p.renderer.ExitAltScreen()
+	}
+
+	h.deliver(p.Model.Init())
+	h.settle()
+
+	width, height := p.GetSize()
+	h.deliver(SizeMsg{Width: width, Height: height})
+	h.settle()
+
+	for _, step := range p.script.steps {
+		if h.quit {
+			break
+		}
+		if step.msg == nil {
+			h.advance(step.wait)
+			continue
+		}
+		if size, ok := step.msg.(SizeMsg); ok {
+			p.renderer.Resize(size.Width, size.Height)
+		}
+		h.deliver(step.msg)
+		h.settle()
+	}
+
+	return nil
+}
@@ -2,52 +2,80 @@
 package engine
 
 import (
+	"errors"
+	"io"
 	"os"
+	"time"
 )
 
+// escapeDelay is how long input waits for the rest of a sequence before decoding what it has,
+// a lone ESC becoming the Esc key
+const escapeDelay = 50 * time.Millisecond
+
 // ReadInput reads from stdin and sends KeyMsg/QuitMsg to the provided channel
-// Handles escape sequences for arrow keys and Ctrl+C termination
+// Every key of a read is decoded, including escape sequences, paste and UTF-8 runes
 func ReadInput(msgs chan<- Msg) {
-	buf := make([]byte, 1024)
+	readInput(os.Stdin, msgs, escapeDelay)
+}
 
-	for {
-		n, err := os.Stdin.Read(buf)
-		if err != nil || n == 0 {
-			continue
-		}
+// readInput decodes keys from in until Ctrl+C or end of input.
+// Input left incomplete for delay is flushed, so split sequences are joined and a lone ESC still arrives.
+func readInput(in io.Reader, msgs chan<- Msg, delay time.Duration) {
+	chunks := make(chan []byte)
+	done := make(chan struct{})
+	defer close(done)
 
-		data := buf[:n]
-
-		if len(data) >= 3 && data[0] == 0x1b && data[1] == '[' {
-			switch data[2] {
-			case 'A':
-				msgs <- KeyMsg{Rune: '↑'}
-				continue
-			case 'B':
-				msgs <- KeyMsg{Rune: '↓'}
-				continue
-			case 'C':
-				msgs <- KeyMsg{Rune: '→'}
-				continue
-			case 'D':
-				msgs <- KeyMsg{Rune: '←'}
-				continue
+	go func() {
+		defer close(chunks)
+		buf := make([]byte, 1024)
+		for {
+			n, err := in.Read(buf)
+			if n > 0 {
+				chunk := append([]byte(nil), buf[:n]...)
+				select {
+				case chunks <- chunk:
+				case <-done:
+					return
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				return
 			}
 		}
+	}()
 
-		switch data[0] {
-		case 3:
-			msgs <- QuitMsg{}
-			return
-		case 0x1b:
-			if len(data) < 3 {
-				continue
-			}
-			fallthrough
-		default:
-			if len(data) == 1 {
-				msgs <- KeyMsg{Rune: rune(data[0])}
+	var parser KeyParser
+	var flush <-chan time.Time
+	for {
+		var decoded []Msg
+		select {
+		case chunk, ok := <-chunks:
+			if !ok {
+				sendKeys(msgs, parser.Flush())
+				return
 			}
+			decoded = parser.Feed(chunk)
+		case <-flush:
+			decoded = parser.Flush()
+		}
+		if sendKeys(msgs, decoded) {
+			return
+		}
+
+		flush = nil
+		if parser.Pending() {
+			flush = time.After(delay)
+		}
+	}
+}
+
+// sendKeys sends decoded messages in order, reporting whether one of them quits
+func sendKeys(msgs chan<- Msg, decoded []Msg) bool {
+	for _, msg := range decoded {
+		msgs <- msg
+		if _, ok := msg.(QuitMsg); ok {
+			return true
 		}
 	}
+	return false
 }
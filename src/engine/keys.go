@@ -0,0 +1,445 @@
+package engine
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// KeyType identifies which key produced a KeyMsg
+type KeyType int
+
+const (
+	KeyRune KeyType = iota // Printable character or Ctrl+letter, see KeyMsg.Rune
+	KeyEnter
+	KeyTab
+	KeyBackspace
+	KeyEscape
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyHome
+	KeyEnd
+	KeyPgUp
+	KeyPgDown
+	KeyInsert
+	KeyDelete
+	KeyF1
+	KeyF2
+	KeyF3
+	KeyF4
+	KeyF5
+	KeyF6
+	KeyF7
+	KeyF8
+	KeyF9
+	KeyF10
+	KeyF11
+	KeyF12
+	KeyPaste // Bracketed paste, the content is in KeyMsg.Text
+)
+
+// keyNames are the names used by KeyMsg.String
+var keyNames = map[KeyType]string{
+	KeyEnter:     "enter",
+	KeyTab:       "tab",
+	KeyBackspace: "backspace",
+	KeyEscape:    "esc",
+	KeyUp:        "up",
+	KeyDown:      "down",
+	KeyLeft:      "left",
+	KeyRight:     "right",
+	KeyHome:      "home",
+	KeyEnd:       "end",
+	KeyPgUp:      "pgup",
+	KeyPgDown:    "pgdown",
+	KeyInsert:    "insert",
+	KeyDelete:    "delete",
+	KeyF1:        "f1",
+	KeyF2:        "f2",
+	KeyF3:        "f3",
+	KeyF4:        "f4",
+	KeyF5:        "f5",
+	KeyF6:        "f6",
+	KeyF7:        "f7",
+	KeyF8:        "f8",
+	KeyF9:        "f9",
+	KeyF10:       "f10",
+	KeyF11:       "f11",
+	KeyF12:       "f12",
+	KeyPaste:     "paste",
+}
+
+// KeyMod is a bitmask of the modifiers held with a key
+type KeyMod int
+
+const (
+	ModShift KeyMod = 1 << iota
+	ModAlt
+	ModCtrl
+)
+
+// Legacy runes kept in KeyMsg.Rune so handlers matching on runes keep working
+const (
+	RuneEnter     = '\r'
+	RuneTab       = '\t'
+	RuneEscape    = 27
+	RuneBackspace = 127
+	RuneUp        = '↑'
+	RuneDown      = '↓'
+	RuneLeft      = '←'
+	RuneRight     = '→'
+)
+
+// arrowRunes maps arrow keys to their legacy runes
+var arrowRunes = map[KeyType]rune{
+	KeyUp:    RuneUp,
+	KeyDown:  RuneDown,
+	KeyLeft:  RuneLeft,
+	KeyRight: RuneRight,
+}
+
+// KeyFromRune builds the KeyMsg a terminal would send for r, accepting legacy runes
+func KeyFromRune(r rune) KeyMsg {
+	switch r {
+	case '\r', '\n':
+		return KeyMsg{Rune: r, Type: KeyEnter}
+	case RuneTab:
+		return KeyMsg{Rune: r, Type: KeyTab}
+	case RuneEscape:
+		return KeyMsg{Rune: r, Type: KeyEscape}
+	case RuneBackspace, '\b':
+		return KeyMsg{Rune: r, Type: KeyBackspace}
+	}
+	for keyType, arrow := range arrowRunes {
+		if r == arrow {
+			return KeyMsg{Rune: r, Type: keyType}
+		}
+	}
+	if r < 0x20 {
+		return KeyMsg{Rune: r, Type: KeyRune, Mod: ModCtrl}
+	}
+	return KeyMsg{Rune: r, Type: KeyRune}
+}
+
+// keyFromType builds a KeyMsg for a non-character key
+func keyFromType(keyType KeyType, mod KeyMod) KeyMsg {
+	key := KeyMsg{Type: keyType, Mod: mod}
+	if arrow, ok := arrowRunes[keyType]; ok {
+		key.Rune = arrow
+	}
+	return key
+}
+
+// String returns a readable name such as "a", "ctrl+s", "alt+enter" or "shift+up"
+func (k KeyMsg) String() string {
+	var sb strings.Builder
+	if k.Mod&ModCtrl != 0 {
+		sb.WriteString("ctrl+")
+	}
+	if k.Mod&ModAlt != 0 {
+		sb.WriteString("alt+")
+	}
+	if k.Mod&ModShift != 0 {
+		sb.WriteString("shift+")
+	}
+
+	switch {
+	case k.Type == KeyPaste:
+		sb.WriteString("paste")
+	case k.Type != KeyRune:
+		sb.WriteString(keyNames[k.Type])
+	case k.Rune == ' ':
+		sb.WriteString("space")
+	case k.Rune < 0x20:
+		// Control characters are shown as the letter typed with Ctrl
+		sb.WriteRune(k.Rune + 'a' - 1)
+	default:
+		sb.WriteRune(k.Rune)
+	}
+	return sb.String()
+}
+
+// Escape sequences delimiting a bracketed paste
+var (
+	pasteStart = []byte("\x1b[200~")
+	pasteEnd   = []byte("\x1b[201~")
+)
+
+// tildeKeys maps the numeric parameter of "CSI n ~" sequences to keys
+var tildeKeys = map[int]KeyType{
+	1: KeyHome, 7: KeyHome,
+	4: KeyEnd, 8: KeyEnd,
+	2:  KeyInsert,
+	3:  KeyDelete,
+	5:  KeyPgUp,
+	6:  KeyPgDown,
+	11: KeyF1, 12: KeyF2, 13: KeyF3, 14: KeyF4, 15: KeyF5,
+	17: KeyF6, 18: KeyF7, 19: KeyF8, 20: KeyF9, 21: KeyF10,
+	23: KeyF11, 24: KeyF12,
+}
+
+// letterKeys maps the final byte of "CSI x" and "SS3 x" sequences to keys
+var letterKeys = map[byte]KeyType{
+	'A': KeyUp,
+	'B': KeyDown,
+	'C': KeyRight,
+	'D': KeyLeft,
+	'H': KeyHome,
+	'F': KeyEnd,
+	'P': KeyF1,
+	'Q': KeyF2,
+	'R': KeyF3,
+	'S': KeyF4,
+}
+
+// maxPasteSize is the most bytes of a bracketed paste buffered while waiting for its end marker
+const maxPasteSize = 64 * 1024
+
+// KeyParser decodes raw terminal input into key messages.
+// Bytes of a sequence split across reads are kept until the rest arrives,
+// Flush decodes them when the rest does not come, such as a lone ESC being the Esc key.
+type KeyParser struct {
+	pending []byte
+}
+
+// Feed decodes data and returns the messages it completes
+func (p *KeyParser) Feed(data []byte) []Msg {
+	p.pending = append(p.pending, data...)
+
+	var msgs []Msg
+	for len(p.pending) > 0 {
+		msg, n := parseKey(p.pending)
+		if n == 0 {
+			// Incomplete sequence, wait for more input
+			break
+		}
+		p.pending = p.pending[n:]
+		if msg != nil {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	if len(p.pending) == 0 {
+		p.pending = nil
+	}
+	return msgs
+}
+
+// Pending reports whether input waits for the rest of a sequence
+func (p *KeyParser) Pending() bool {
+	return len(p.pending) > 0
+}
+
+// Flush decodes the input waiting for the rest of a sequence, as if no more input will come
+func (p *KeyParser) Flush() []Msg {
+	var msgs []Msg
+	for len(p.pending) > 0 {
+		msg, n := parseKey(p.pending)
+		if n == 0 {
+			msg, n = flushKey(p.pending)
+		}
+		p.pending = p.pending[n:]
+		if msg != nil {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	p.pending = nil
+	return msgs
+}
+
+// ParseKeys decodes a complete chunk of terminal input
+func ParseKeys(data []byte) []Msg {
+	var p KeyParser
+	msgs := p.Feed(data)
+	return append(msgs, p.Flush()...)
+}
+
+// parseKey decodes the first key in data.
+// It returns the message (nil for ignored input) and the bytes consumed, 0 if data is incomplete.
+func parseKey(data []byte) (Msg, int) {
+	switch c := data[0]; {
+	case c == 3:
+		return QuitMsg{}, 1
+	case c == 0x1b:
+		return parseEscape(data)
+	case c < 0x20 || c == 0x7f:
+		return KeyFromRune(rune(c)), 1
+	}
+
+	if !utf8.FullRune(data) {
+		return nil, 0
+	}
+	r, size := utf8.DecodeRune(data)
+	if r == utf8.RuneError {
+		// Invalid byte, skip it
+		return nil, size
+	}
+	return KeyMsg{Rune: r, Type: KeyRune}, size
+}
+
+// parseEscape decodes input starting with ESC: Esc alone, Alt+key, CSI or SS3 sequences.
+// A trailing ESC, "ESC [" or "ESC O" may be the start of a sequence split across reads, so they wait for more input.
+func parseEscape(data []byte) (Msg, int) {
+	if len(data) == 1 {
+		return nil, 0
+	}
+
+	switch data[1] {
+	case '[':
+		if len(data) == 2 {
+			return nil, 0
+		}
+		return parseCSI(data)
+	case 'O':
+		if len(data) == 2 {
+			return nil, 0
+		}
+		if keyType, ok := letterKeys[data[2]]; ok {
+			return keyFromType(keyType, 0), 3
+		}
+		return KeyMsg{Rune: 'O', Type: KeyRune, Mod: ModAlt}, 2
+	case 0x1b:
+		if len(data) == 2 {
+			return nil, 0
+		}
+		// Some terminals send Alt+<sequence> as ESC followed by the sequence
+		if data[2] == '[' || data[2] == 'O' {
+			msg, n := parseEscape(data[1:])
+			if n == 0 {
+				return nil, 0
+			}
+			if key, ok := msg.(KeyMsg); ok {
+				key.Mod |= ModAlt
+				return key, n + 1
+			}
+			return msg, n + 1
+		}
+		return KeyFromRune(RuneEscape), 1
+	}
+
+	// Alt+key
+	msg, n := parseKey(data[1:])
+	if n == 0 {
+		return nil, 0
+	}
+	key, ok := msg.(KeyMsg)
+	if !ok {
+		return msg, n + 1
+	}
+	key.Mod |= ModAlt
+	return key, n + 1
+}
+
+// parseCSI decodes a "ESC [ params final" sequence
+func parseCSI(data []byte) (Msg, int) {
+	if bytes.HasPrefix(data, pasteStart) {
+		return parsePaste(data)
+	}
+
+	// Parameter and intermediate bytes, then one final byte
+	end := 2
+	for end < len(data) && data[end] >= 0x20 && data[end] <= 0x3f {
+		end++
+	}
+	if end == len(data) {
+		// Final byte not received yet
+		return nil, 0
+	}
+	final := data[end]
+	if final < 0x40 || final > 0x7e {
+		// Malformed sequence, drop the introducer
+		return nil, 2
+	}
+
+	params := strings.Split(string(data[2:end]), ";")
+	size := end + 1
+
+	mod := KeyMod(0)
+	if len(params) > 1 {
+		mod = decodeModifier(params[1])
+	}
+
+	switch final {
+	case '~':
+		code, err := strconv.Atoi(params[0])
+		if err != nil {
+			return nil, size
+		}
+		keyType, ok := tildeKeys[code]
+		if !ok {
+			return nil, size
+		}
+		return keyFromType(keyType, mod), size
+	case 'Z':
+		return KeyMsg{Rune: RuneTab, Type: KeyTab, Mod: ModShift}, size
+	}
+
+	if keyType, ok := letterKeys[final]; ok {
+		return keyFromType(keyType, mod), size
+	}
+	return nil, size
+}
+
+// parsePaste decodes a bracketed paste, waiting until the end marker arrives.
+// A paste growing past maxPasteSize without its end marker is emitted as it is.
+func parsePaste(data []byte) (Msg, int) {
+	body := data[len(pasteStart):]
+	idx := bytes.Index(body, pasteEnd)
+	if idx < 0 {
+		if len(body) >= maxPasteSize {
+			return pasteKey(body), len(data)
+		}
+		return nil, 0
+	}
+
+	return pasteKey(body[:idx]), len(pasteStart) + idx + len(pasteEnd)
+}
+
+// pasteKey builds the message of pasted bytes, dropping invalid UTF-8
+func pasteKey(body []byte) KeyMsg {
+	return KeyMsg{Type: KeyPaste, Text: strings.ToValidUTF8(string(body), "")}
+}
+
+// flushKey decodes the first key of incomplete input whose rest will not come.
+// It returns the message (nil for dropped input) and the bytes consumed, always at least one.
+func flushKey(data []byte) (Msg, int) {
+	switch {
+	case bytes.HasPrefix(data, pasteStart):
+		// Paste whose end marker never came
+		return pasteKey(data[len(pasteStart):]), len(data)
+	case data[0] != 0x1b:
+		// Truncated UTF-8 rune
+		return nil, len(data)
+	case len(data) == 1 || data[1] == 0x1b:
+		return KeyFromRune(RuneEscape), 1
+	case len(data) == 2 && (data[1] == '[' || data[1] == 'O'):
+		return KeyMsg{Rune: rune(data[1]), Type: KeyRune, Mod: ModAlt}, 2
+	}
+	// Truncated sequence
+	return nil, len(data)
+}
+
+// decodeModifier converts an xterm modifier parameter (1 + bitmask) to a KeyMod
+func decodeModifier(param string) KeyMod {
+	value, err := strconv.Atoi(param)
+	if err != nil || value < 1 {
+		return 0
+	}
+	bits := value - 1
+
+	var mod KeyMod
+	if bits&1 != 0 {
+		mod |= ModShift
+	}
+	if bits&2 != 0 {
+		mod |= ModAlt
+	}
+	if bits&4 != 0 {
+		mod |= ModCtrl
+	}
+	return mod
+}
@@ -0,0 +1,157 @@
+package engine
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// key builds the KeyMsg of a non-character key
+func key(keyType KeyType, mod KeyMod) KeyMsg {
+	return keyFromType(keyType, mod)
+}
+
+// char builds the KeyMsg of a character typed with mod
+func char(r rune, mod KeyMod) KeyMsg {
+	return KeyMsg{Rune: r, Type: KeyRune, Mod: mod}
+}
+
+func TestKeyParser(t *testing.T) {
+	tests := []struct {
+		name   string
+		chunks []string // Reads fed one after the other
+		want   []Msg
+	}{
+		{"letters in one read", []string{"ab"}, []Msg{char('a', 0), char('b', 0)}},
+		{"utf8 rune", []string{"é"}, []Msg{char('é', 0)}},
+		{"utf8 rune split", []string{"\xc3", "\xa9"}, []Msg{char('é', 0)}},
+		{"enter", []string{"\r"}, []Msg{KeyFromRune('\r')}},
+		{"tab and shift tab", []string{"\t\x1b[Z"}, []Msg{KeyFromRune('\t'), KeyMsg{Rune: RuneTab, Type: KeyTab, Mod: ModShift}}},
+		{"backspace", []string{"\x7f"}, []Msg{KeyFromRune(RuneBackspace)}},
+		{"ctrl letter", []string{"\x13"}, []Msg{char(0x13, ModCtrl)}},
+		{"ctrl c", []string{"\x03"}, []Msg{QuitMsg{}}},
+
+		{"esc alone", []string{"\x1b"}, []Msg{KeyFromRune(RuneEscape)}},
+		{"double esc", []string{"\x1b\x1b"}, []Msg{KeyFromRune(RuneEscape), KeyFromRune(RuneEscape)}},
+		{"alt letter", []string{"\x1ba"}, []Msg{char('a', ModAlt)}},
+		{"alt utf8 rune", []string{"\x1bé"}, []Msg{char('é', ModAlt)}},
+		{"alt bracket", []string{"\x1b["}, []Msg{char('[', ModAlt)}},
+		{"alt O", []string{"\x1bO"}, []Msg{char('O', ModAlt)}},
+
+		{"arrows", []string{"\x1b[A\x1b[B\x1b[C\x1b[D"}, []Msg{key(KeyUp, 0), key(KeyDown, 0), key(KeyRight, 0), key(KeyLeft, 0)}},
+		{"arrows in application mode", []string{"\x1bOA\x1bOD"}, []Msg{key(KeyUp, 0), key(KeyLeft, 0)}},
+		{"shift arrow", []string{"\x1b[1;2A"}, []Msg{key(KeyUp, ModShift)}},
+		{"ctrl arrow", []string{"\x1b[1;5C"}, []Msg{key(KeyRight, ModCtrl)}},
+		{"ctrl shift arrow", []string{"\x1b[1;6D"}, []Msg{key(KeyLeft, ModCtrl|ModShift)}},
+		{"alt arrow as esc prefix", []string{"\x1b\x1b[B"}, []Msg{key(KeyDown, ModAlt)}},
+
+		{"home end xterm", []string{"\x1b[H\x1b[F"}, []Msg{key(KeyHome, 0), key(KeyEnd, 0)}},
+		{"home end vt", []string{"\x1b[1~\x1b[4~\x1b[7~\x1b[8~"}, []Msg{key(KeyHome, 0), key(KeyEnd, 0), key(KeyHome, 0), key(KeyEnd, 0)}},
+		{"page up down", []string{"\x1b[5~\x1b[6~"}, []Msg{key(KeyPgUp, 0), key(KeyPgDown, 0)}},
+		{"ctrl page down", []string{"\x1b[6;5~"}, []Msg{key(KeyPgDown, ModCtrl)}},
+		{"insert delete", []string{"\x1b[2~\x1b[3~"}, []Msg{key(KeyInsert, 0), key(KeyDelete, 0)}},
+
+		{"f1 to f4", []string{"\x1bOP\x1bOQ\x1bOR\x1bOS"}, []Msg{key(KeyF1, 0), key(KeyF2, 0), key(KeyF3, 0), key(KeyF4, 0)}},
+		{"f1 to f4 vt", []string{"\x1b[11~\x1b[12~\x1b[13~\x1b[14~"}, []Msg{key(KeyF1, 0), key(KeyF2, 0), key(KeyF3, 0), key(KeyF4, 0)}},
+		{"f5 to f12", []string{"\x1b[15~\x1b[17~\x1b[18~\x1b[19~\x1b[20~\x1b[21~\x1b[23~\x1b[24~"}, []Msg{
+			key(KeyF5, 0), key(KeyF6, 0), key(KeyF7, 0), key(KeyF8, 0),
+			key(KeyF9, 0), key(KeyF10, 0), key(KeyF11, 0), key(KeyF12, 0),
+		}},
+		{"shift f5", []string{"\x1b[15;2~"}, []Msg{key(KeyF5, ModShift)}},
+		{"unknown sequence", []string{"\x1b[99~x"}, []Msg{char('x', 0)}},
+
+		{"arrow split after esc", []string{"\x1b", "[A"}, []Msg{key(KeyUp, 0)}},
+		{"arrow split after bracket", []string{"\x1b[", "A"}, []Msg{key(KeyUp, 0)}},
+		{"modified arrow split in params", []string{"\x1b[1;", "5A"}, []Msg{key(KeyUp, ModCtrl)}},
+		{"function key split", []string{"\x1bO", "P"}, []Msg{key(KeyF1, 0)}},
+		{"alt arrow split", []string{"\x1b\x1b", "[A"}, []Msg{key(KeyUp, ModAlt)}},
+		{"keys around split sequence", []string{"a\x1b[", "1~b"}, []Msg{char('a', 0), key(KeyHome, 0), char('b', 0)}},
+		{"truncated sequence dropped", []string{"\x1b[1;"}, nil},
+
+		{"paste", []string{"\x1b[200~héllo\r\x1b[201~"}, []Msg{KeyMsg{Type: KeyPaste, Text: "héllo\r"}}},
+		{"paste split", []string{"\x1b[20", "0~ab", "c\x1b[2", "01~d"}, []Msg{KeyMsg{Type: KeyPaste, Text: "abc"}, char('d', 0)}},
+		{"paste keeps escapes", []string{"\x1b[200~\x1b[A\x03\x1b[201~"}, []Msg{KeyMsg{Type: KeyPaste, Text: "\x1b[A\x03"}}},
+		{"unterminated paste", []string{"\x1b[200~abc"}, []Msg{KeyMsg{Type: KeyPaste, Text: "abc"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p KeyParser
+			var got []Msg
+			for _, chunk := range tt.chunks {
+				got = append(got, p.Feed([]byte(chunk))...)
+			}
+			got = append(got, p.Flush()...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyParserWaitsForSplitSequence(t *testing.T) {
+	var p KeyParser
+	for _, prefix := range []string{"\x1b", "\x1b[", "\x1bO", "\x1b\x1b", "\x1b[200~abc"} {
+		if got := p.Feed([]byte(prefix)); len(got) != 0 {
+			t.Errorf("Feed(%q) = %v, want no key before the rest arrives", prefix, got)
+		}
+		if !p.Pending() {
+			t.Errorf("Feed(%q) left nothing pending", prefix)
+		}
+		p.Flush()
+	}
+}
+
+func TestKeyParserCapsUnterminatedPaste(t *testing.T) {
+	var p KeyParser
+	body := strings.Repeat("a", maxPasteSize)
+	got := p.Feed([]byte("\x1b[200~" + body))
+	want := []Msg{KeyMsg{Type: KeyPaste, Text: body}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %d messages, want the buffered paste once it reaches the cap", len(got))
+	}
+
+	// Keys typed after the runaway paste are decoded again
+	if got := p.Feed([]byte("\x03")); !reflect.DeepEqual(got, []Msg{QuitMsg{}}) {
+		t.Errorf("got %v after the paste, want Ctrl+C to quit", got)
+	}
+}
+
+func TestReadInputFlushesLoneEscape(t *testing.T) {
+	in, out := io.Pipe()
+	msgs := make(chan Msg)
+	go readInput(in, msgs, 10*time.Millisecond)
+
+	go out.Write([]byte("\x1b"))
+	select {
+	case msg := <-msgs:
+		if msg != KeyFromRune(RuneEscape) {
+			t.Errorf("got %v, want Esc", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("lone ESC was never flushed")
+	}
+
+	go out.Write([]byte("\x1b[200~abc"))
+	select {
+	case msg := <-msgs:
+		if want := (KeyMsg{Type: KeyPaste, Text: "abc"}); msg != want {
+			t.Errorf("got %v, want the buffered paste", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("unterminated paste was never flushed")
+	}
+
+	go out.Write([]byte("\x03"))
+	select {
+	case msg := <-msgs:
+		if _, ok := msg.(QuitMsg); !ok {
+			t.Errorf("got %v, want Ctrl+C to quit", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Ctrl+C was not delivered")
+	}
+	out.Close()
+}
@@ -3,7 +3,9 @@ package engine
 import (
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/charmbracelet/colorprofile"
 	"golang.org/x/term"
 )
 
@@ -14,10 +16,54 @@ type Program struct {
 
 	useAltScreen bool
 
+	colorProfile *colorprofile.Profile // Overrides the detected color profile when set
+
+	script *Script       // Scripted input, set for headless runs
+	clock  *VirtualClock // Virtual clock driving Tick in headless runs
+
+	timers *scheduler // Named timers and frames started by commands
+
 	quit bool
 }
 type ProgramOption func(*Program)
 
+// WithHeadless runs the program without a terminal, feeding it the given script.
+// Frames go to an in-memory renderer unless WithRenderer is also used.
+func WithHeadless(script *Script) ProgramOption {
+	return func(p *Program) {
+		if script == nil {
+			script = NewScript()
+		}
+		p.script = script
+	}
+}
+
+// WithRenderer replaces the default terminal renderer
+func WithRenderer(r Renderer) ProgramOption {
+	return func(p *Program) {
+		p.renderer = r
+	}
+}
+
+// WithClock sets the virtual clock used by headless runs
+func WithClock(clock *VirtualClock) ProgramOption {
+	return func(p *Program) {
+		p.clock = clock
+	}
+}
+
+// WithColorProfile forces the color profile instead of detecting it from the terminal
+func WithColorProfile(profile colorprofile.Profile) ProgramOption {
+	return func(p *Program) {
+		p.colorProfile = &profile
+	}
+}
+
+// IsHeadless reports whether the program runs without a terminal
+func (p *Program) IsHeadless() bool {
+	return p.script != nil
+}
+
 // WithAltScreen enables alternate screen buffer for full-screen applications
 func WithAltScreen() ProgramOption {
 	return func(p *Program) {
@@ -27,6 +73,10 @@ func WithAltScreen() ProgramOption {
 
 // GetSize returns terminal width and height, defaulting to 80x24 for non-terminals
 func (p *Program) GetSize() (int, int) {
+	if p.IsHeadless() {
+		return p.renderer.GetSize()
+	}
+
 	fd := int(os.Stdin.Fd())
 
 	if !term.IsTerminal(fd) {
@@ -54,20 +104,35 @@ func (p *Program) GetRenderer() Renderer {
 // NewProgram creates a new Program with model and applies provided options
 func NewProgram(model Model, opts ...ProgramOption) *Program {
 	p := &Program{
-		Model:    model,
-		renderer: NewRenderer(os.Stdout),
-		msgs:     make(chan Msg),
+		Model:  model,
+		msgs:   make(chan Msg),
+		timers: newScheduler(),
 	}
 
 	for _, opt := range opts {
 		opt(p)
 	}
 
+	if p.renderer == nil {
+		if p.IsHeadless() {
+			p.renderer = NewMemoryRenderer()
+		} else {
+			p.renderer = NewRenderer(os.Stdout)
+		}
+	}
+
+	if p.IsHeadless() && p.clock == nil {
+		p.clock = NewVirtualClock(time.Unix(0, 0))
+	}
+
 	return p
 }
 
 // Run starts the program main loop, setting up terminal and handling input/rendering
 func (p *Program) Run() error {
+	if p.IsHeadless() {
+		return p.runHeadless()
+	}
 
 	fd := int(os.Stdin.Fd())
 	oldState, err := term.MakeRaw(fd)
@@ -80,12 +145,20 @@ func (p *Program) Run() error {
 	defer p.renderer.Stop()
 
 	SetGlobalRenderer(p.renderer)
+	if p.colorProfile != nil {
+		SetColorProfile(*p.colorProfile)
+	} else {
+		SetColorProfile(colorprofile.Detect(os.Stdout, os.Environ()))
+	}
 
 	if p.useAltScreen {
 		p.renderer.EnterAltScreen()
 		defer p.renderer.ExitAltScreen()
 	}
 
+	p.renderer.EnableBracketedPaste()
+	defer p.renderer.DisableBracketedPaste()
+
 	p.renderer.HideCursor()
 	go ReadInput(p.msgs)
 
@@ -103,6 +176,7 @@ func (p *Program) Run() error {
 	}
 
 	width, height := p.GetSize()
+	p.renderer.Resize(width, height)
 
 	p.Model, cmd = p.Model.Update(SizeMsg{Width: width, Height: height})
 	if cmd != nil {
@@ -111,18 +185,55 @@ func (p *Program) Run() error {
 		}()
 	}
 
+	// Forward terminal resizes to the model until the program exits
+	done := make(chan struct{})
+	defer close(done)
+	go watchResize(done, func() {
+		width, height := p.GetSize()
+		p.renderer.Resize(width, height)
+		select {
+		case p.msgs <- SizeMsg{Width: width, Height: height}:
+		case <-done:
+		}
+	})
+
 	for !p.quit {
 		view := p.Model.View()
 
 		p.renderer.Write(view)
 
-		msg := <-p.msgs
+		// Wake up for the next timer unless a message arrives first
+		var fire <-chan time.Time
+		var wake *time.Timer
+		if deadline, ok := p.timers.nextDeadline(); ok {
+			wake = time.NewTimer(time.Until(deadline))
+			fire = wake.C
+		}
+
+		select {
+		case msg := <-p.msgs:
+			p.handle(msg)
+		case <-fire:
+			for !p.quit {
+				msg, ok := p.timers.popDue(time.Now())
+				if !ok {
+					break
+				}
+				p.handle(msg)
+			}
+		}
 
-		if _, ok := msg.(QuitMsg); ok {
-			p.quit = true
-			return nil
+		if wake != nil {
+			wake.Stop()
 		}
+	}
 
+	return nil
+}
+
+// handle passes timer commands to the scheduler and every other message to the model
+func (p *Program) handle(msg Msg) {
+	if !p.intercept(msg) {
 		var cmd Cmd
 		p.Model, cmd = p.Model.Update(msg)
 
@@ -132,6 +243,19 @@ func (p *Program) Run() error {
 			}()
 		}
 	}
+}
 
-	return nil
+// intercept handles the messages meant for the program itself and reports whether msg was one
+func (p *Program) intercept(msg Msg) bool {
+	switch msg := msg.(type) {
+	case QuitMsg:
+		p.quit = true
+	case scheduleMsg:
+		p.timers.schedule(msg, GetGlobalClock().Now())
+	case cancelMsg:
+		p.timers.cancel(msg.name)
+	default:
+		return false
+	}
+	return true
 }
@@ -39,6 +39,14 @@ type Renderer interface {
 	SetCursor(x, y int)
 	// Get current terminal dimensions
 	GetSize() (width int, height int)
+	// Update terminal dimensions and redraw everything
+	Resize(width, height int)
+	// Ask the terminal to wrap pasted text in bracketed paste markers
+	EnableBracketedPaste()
+	// Stop wrapping pasted text
+	DisableBracketedPaste()
+	// Output statistics of the frames written so far
+	Stats() RenderStats
 }
 
 type StandardRenderer struct {
@@ -52,6 +60,7 @@ type StandardRenderer struct {
 	done               chan struct{}
 	lastRender         string
 	lastRenderedLines  []string
+	lastFrame          *frame
 	linesRendered      int
 	altLinesRendered   int
 	once               sync.Once
@@ -64,6 +73,25 @@ type StandardRenderer struct {
 	height int
 
 	ignoreLines map[int]struct{}
+
+	stats RenderStats
+}
+
+// RenderStats reports the output cost of the frames flushed to the terminal
+type RenderStats struct {
+	Frames         int           // Frames written to the terminal
+	BytesWritten   int64         // Bytes written by all frames
+	LastFrameBytes int           // Bytes written by the last frame
+	LastFrameTime  time.Duration // Time spent building and writing the last frame
+	TotalFrameTime time.Duration // Time spent building and writing all frames
+}
+
+// AverageFrameTime returns the mean time spent per frame
+func (s RenderStats) AverageFrameTime() time.Duration {
+	if s.Frames == 0 {
+		return 0
+	}
+	return s.TotalFrameTime / time.Duration(s.Frames)
 }
 
 // NewRenderer creates a StandardRenderer with default 24fps frameRate
@@ -136,7 +164,7 @@ func (r *StandardRenderer) listen() {
 	}
 }
 
-// flush outputs buffered content to terminal with line-by-line optimization
+// flush outputs buffered content to terminal, only the cells that changed are redrawn in the alternate screen
 func (r *StandardRenderer) flush() {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
@@ -145,8 +173,52 @@ func (r *StandardRenderer) flush() {
 		return
 	}
 
+	start := time.Now()
 	buf := &bytes.Buffer{}
 
+	if r.altScreenActive && r.width > 0 && r.height > 0 {
+		r.renderCells(buf)
+	} else {
+		r.renderLines(buf)
+	}
+
+	n, _ := r.out.Write(buf.Bytes())
+	r.lastRender = r.buf.String()
+	r.buf.Reset()
+
+	elapsed := time.Since(start)
+	r.stats.Frames++
+	r.stats.BytesWritten += int64(n)
+	r.stats.LastFrameBytes = n
+	r.stats.LastFrameTime = elapsed
+	r.stats.TotalFrameTime += elapsed
+}
+
+// renderCells writes the cursor moves and cell runs that changed since the previous frame
+func (r *StandardRenderer) renderCells(buf *bytes.Buffer) {
+	newLines := strings.Split(r.buf.String(), "\n")
+	if len(newLines) > r.height {
+		newLines = newLines[len(newLines)-r.height:]
+	}
+
+	next := parseFrame(newLines, r.width, r.height)
+	prev := r.lastFrame
+	if prev == nil || prev.width != next.width || prev.height != next.height {
+		// Nothing on screen can be trusted, start from a blank one
+		buf.WriteString(ansi.EraseEntireScreen)
+		prev = newFrame(r.width, r.height)
+	}
+
+	diffFrames(buf, prev, next, r.ignoreLines)
+	buf.WriteString(ansi.CursorPosition(0, len(newLines)))
+
+	r.altLinesRendered = len(newLines)
+	r.lastFrame = next
+	r.lastRenderedLines = newLines
+}
+
+// renderLines rewrites the frame line by line, used for inline rendering
+func (r *StandardRenderer) renderLines(buf *bytes.Buffer) {
 	if r.altScreenActive {
 		buf.WriteString(ansi.CursorHomePosition)
 	} else if r.linesRendered < 1 {
@@ -220,11 +292,7 @@ func (r *StandardRenderer) flush() {
 		buf.WriteByte('\r')
 	}
 
-	_, _ = r.out.Write(buf.Bytes())
-	r.lastRender = r.buf.String()
-
 	r.lastRenderedLines = newLines
-	r.buf.Reset()
 }
 
 // lastLinesRendered returns appropriate line count based on screen mode
@@ -250,6 +318,7 @@ func (r *StandardRenderer) ClearScreen() {
 func (r *StandardRenderer) Repaint() {
 	r.lastRender = ""
 	r.lastRenderedLines = nil
+	r.lastFrame = nil
 }
 
 // AltScreen returns whether alternate screen buffer is currently active
@@ -359,3 +428,44 @@ func (r *StandardRenderer) GetSize() (int, int) {
 	defer r.mtx.Unlock()
 	return r.width, r.height
 }
+
+// Resize stores the new terminal dimensions and clears the screen so the next flush redraws it
+func (r *StandardRenderer) Resize(width, height int) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.width = width
+	r.height = height
+
+	r.execute(ansi.EraseEntireScreen)
+	r.execute(ansi.CursorHomePosition)
+	r.altLinesRendered = 0
+	r.linesRendered = 0
+
+	// Queue the last frame again so the screen is not left blank until the next view
+	if r.buf.Len() == 0 && r.lastRender != "" {
+		r.buf.WriteString(r.lastRender)
+	}
+	r.Repaint()
+}
+
+// Stats returns the output statistics of the frames flushed so far
+func (r *StandardRenderer) Stats() RenderStats {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.stats
+}
+
+// EnableBracketedPaste makes the terminal report pastes as a single sequence
+func (r *StandardRenderer) EnableBracketedPaste() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.execute(ansi.SetBracketedPasteMode)
+}
+
+// DisableBracketedPaste restores normal paste handling
+func (r *StandardRenderer) DisableBracketedPaste() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.execute(ansi.ResetBracketedPasteMode)
+}
@@ -0,0 +1,226 @@
+package engine
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// screenCell is a cell of the emulated terminal
+type screenCell struct {
+	content string
+	pen     string
+}
+
+// screen emulates the part of a terminal the renderer drives: printing, cursor moves, erases and SGR
+type screen struct {
+	width, height int
+	cells         [][]screenCell
+	x, y          int
+	pen           string
+}
+
+func newScreen(width, height int) *screen {
+	s := &screen{width: width, height: height}
+	s.cells = make([][]screenCell, height)
+	for y := range s.cells {
+		s.cells[y] = make([]screenCell, width)
+		s.erase(y, 0, width)
+	}
+	return s
+}
+
+// erase blanks the cells of row y from x0 to x1
+func (s *screen) erase(y, x0, x1 int) {
+	if y < 0 || y >= s.height {
+		return
+	}
+	for x := max(x0, 0); x < min(x1, s.width); x++ {
+		s.cells[y][x] = screenCell{content: " "}
+	}
+}
+
+// apply interprets terminal output
+func (s *screen) apply(out []byte) {
+	data := string(out)
+	var state byte
+	for len(data) > 0 {
+		seq, width, n, newState := ansi.DecodeSequence(data, state, nil)
+		state = newState
+		data = data[n:]
+
+		switch {
+		case width > 0:
+			if s.x+width <= s.width && s.y < s.height {
+				s.cells[s.y][s.x] = screenCell{content: seq, pen: s.pen}
+				for i := 1; i < width; i++ {
+					s.cells[s.y][s.x+i] = screenCell{pen: s.pen}
+				}
+			}
+			s.x += width
+		case seq == "\r":
+			s.x = 0
+		case seq == "\n":
+			s.y++
+		case strings.HasPrefix(seq, "\x1b["):
+			s.csi(seq)
+		}
+	}
+}
+
+// csi interprets a control sequence
+func (s *screen) csi(seq string) {
+	final := seq[len(seq)-1]
+	params := strings.Split(seq[2:len(seq)-1], ";")
+	param := func(i, def int) int {
+		if i >= len(params) || params[i] == "" {
+			return def
+		}
+		v, err := strconv.Atoi(params[i])
+		if err != nil {
+			return def
+		}
+		return v
+	}
+
+	switch final {
+	case 'm':
+		s.pen = applySGR(s.pen, seq)
+	case 'H':
+		s.y, s.x = param(0, 1)-1, param(1, 1)-1
+	case 'A':
+		s.y = max(s.y-param(0, 1), 0)
+	case 'J':
+		switch param(0, 0) {
+		case 2:
+			for y := range s.cells {
+				s.erase(y, 0, s.width)
+			}
+		case 0:
+			s.erase(s.y, s.x, s.width)
+			for y := s.y + 1; y < s.height; y++ {
+				s.erase(y, 0, s.width)
+			}
+		}
+	case 'K':
+		if param(0, 0) == 0 {
+			s.erase(s.y, s.x, s.width)
+		}
+	}
+}
+
+// String returns the screen as lines of cells with their pens, for comparisons
+func (s *screen) String() string {
+	var sb strings.Builder
+	for _, row := range s.cells {
+		for _, cell := range row {
+			sb.WriteString(cell.pen)
+			sb.WriteString(cell.content)
+			sb.WriteString("|")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// newTestRenderer returns a renderer writing to out in the alternate screen
+func newTestRenderer(out *bytes.Buffer, width, height int) *StandardRenderer {
+	r := NewRenderer(out).(*StandardRenderer)
+	r.Resize(width, height)
+	r.EnterAltScreen()
+	return r
+}
+
+// lineRender returns what the line renderer writes to draw view on a cleared alternate screen
+func lineRender(view string, width, height int) []byte {
+	var out bytes.Buffer
+	r := newTestRenderer(&out, width, height)
+	r.Write(view)
+	r.renderLines(&out)
+	return out.Bytes()
+}
+
+// rendererFrames are successive views exercising styles, wide characters and shrinking content
+var rendererFrames = []string{
+	"hello world\n\x1b[31mred\x1b[0m text\nthird line",
+	"hello World\n\x1b[31mred\x1b[0m text\nthird line",
+	"hello World\n\x1b[1;32mgreen\x1b[0m txt\n",
+	"日本語 wide\n\x1b[1;32mgreen\x1b[0m txt\nmore",
+	"a日本 wide\n\x1b[44m  blue bg  \x1b[m\nmore",
+	"short",
+	"\x1b[7m" + strings.Repeat("x", 30) + "\x1b[0m\ntruncated past the right edge of the screen",
+	"line 1\nline 2\nline 3\nline 4\nline 5\nline 6 is past the bottom",
+	"",
+}
+
+func TestCellRendererMatchesLineRenderer(t *testing.T) {
+	const width, height = 20, 5
+
+	var out bytes.Buffer
+	r := newTestRenderer(&out, width, height)
+	term := newScreen(width, height)
+
+	for i, view := range rendererFrames {
+		out.Reset()
+		r.Write(view)
+		r.flush()
+		term.apply(out.Bytes())
+
+		want := newScreen(width, height)
+		want.apply(lineRender(view, width, height))
+		if got, want := term.String(), want.String(); got != want {
+			t.Fatalf("frame %d %q: screen differs from the line renderer\ngot:\n%s\nwant:\n%s", i, view, got, want)
+		}
+	}
+}
+
+func TestCellRendererWritesChangedCells(t *testing.T) {
+	var out bytes.Buffer
+	r := newTestRenderer(&out, 10, 2)
+	r.Write("abc\ndef")
+	r.flush()
+
+	out.Reset()
+	r.Write("abX\ndef")
+	r.flush()
+	want := ansi.CursorPosition(3, 1) + ansi.ResetStyle + "X" + ansi.CursorPosition(0, 2)
+	if got := out.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	out.Reset()
+	r.Write("abX\ndef")
+	r.flush()
+	if out.Len() != 0 {
+		t.Errorf("unchanged frame wrote %q", out.String())
+	}
+}
+
+func TestRendererStats(t *testing.T) {
+	var out bytes.Buffer
+	r := newTestRenderer(&out, 10, 2)
+
+	out.Reset()
+	r.Write("abc\ndef")
+	r.flush()
+	first := out.Len()
+
+	out.Reset()
+	r.Write("abX\ndef")
+	r.flush()
+	second := out.Len()
+
+	stats := r.Stats()
+	if stats.Frames != 2 {
+		t.Errorf("Frames = %d, want 2", stats.Frames)
+	}
+	if stats.BytesWritten != int64(first+second) || stats.LastFrameBytes != second {
+		t.Errorf("bytes = %d total, %d last, want %d and %d", stats.BytesWritten, stats.LastFrameBytes, first+second, second)
+	}
+	if stats.TotalFrameTime < stats.LastFrameTime {
+		t.Errorf("total frame time %s is below the last one %s", stats.TotalFrameTime, stats.LastFrameTime)
+	}
+}
@@ -0,0 +1,25 @@
+//go:build !windows
+
+package engine
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// watchResize calls onResize every time the terminal reports a window size change
+func watchResize(done <-chan struct{}, onResize func()) {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGWINCH)
+	defer signal.Stop(sig)
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-sig:
+			onResize()
+		}
+	}
+}
@@ -0,0 +1,36 @@
+//go:build windows
+
+package engine
+
+import (
+	"os"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// resizePollInterval is how often the console size is checked, Windows has no SIGWINCH
+const resizePollInterval = 250 * time.Millisecond
+
+// watchResize polls the console size and calls onResize when it changes
+func watchResize(done <-chan struct{}, onResize func()) {
+	fd := int(os.Stdout.Fd())
+	width, height, _ := term.GetSize(fd)
+
+	ticker := time.NewTicker(resizePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			w, h, err := term.GetSize(fd)
+			if err != nil || (w == width && h == height) {
+				continue
+			}
+			width, height = w, h
+			onResize()
+		}
+	}
+}
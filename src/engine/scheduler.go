@@ -0,0 +1,160 @@
+package engine
+
+import "time"
+
+// FixedTimestep is the simulated time between two FrameMsg
+const FixedTimestep = time.Second / 60
+
+// maxFrameCatchUp is the number of late frames delivered before the missed ones are dropped
+const maxFrameCatchUp = 5
+
+// FramesTimer is the name of the timer delivering FrameMsg
+const FramesTimer = "frames"
+
+// FrameMsg is sent at a fixed timestep while frames are running.
+// Delta is always FixedTimestep, late frames are caught up instead of stretched.
+type FrameMsg struct {
+	Time  time.Time
+	Delta time.Duration
+}
+
+// TimerMsg is sent when a named timer fires
+type TimerMsg struct {
+	Name string
+	Time time.Time
+}
+
+// scheduleMsg asks the program to start or replace a named timer
+type scheduleMsg struct {
+	name     string
+	delay    time.Duration
+	interval time.Duration // Zero for one-shot timers
+	frames   bool          // Deliver FrameMsg instead of TimerMsg
+}
+
+// cancelMsg asks the program to stop a named timer
+type cancelMsg struct {
+	name string
+}
+
+// After starts a one-shot timer sending TimerMsg{Name: name} after d, replacing any timer with that name
+func After(name string, d time.Duration) Cmd {
+	return func() Msg {
+		return scheduleMsg{name: name, delay: d}
+	}
+}
+
+// Every starts a timer sending TimerMsg{Name: name} every d, replacing any timer with that name
+func Every(name string, d time.Duration) Cmd {
+	return func() Msg {
+		return scheduleMsg{name: name, delay: d, interval: d}
+	}
+}
+
+// Cancel stops the named timer, pending messages of that timer are not sent
+func Cancel(name string) Cmd {
+	return func() Msg {
+		return cancelMsg{name: name}
+	}
+}
+
+// StartFrames starts sending FrameMsg at FixedTimestep, it does nothing when frames already run
+func StartFrames() Cmd {
+	return func() Msg {
+		return scheduleMsg{name: FramesTimer, delay: FixedTimestep, interval: FixedTimestep, frames: true}
+	}
+}
+
+// StopFrames stops sending FrameMsg
+func StopFrames() Cmd {
+	return Cancel(FramesTimer)
+}
+
+// timer is a named entry of the scheduler
+type timer struct {
+	name     string
+	deadline time.Time
+	interval time.Duration
+	frames   bool
+	order    int // Creation order, breaks deadline ties
+}
+
+// scheduler keeps the named timers of a program, it is only used from the program loop
+type scheduler struct {
+	timers map[string]*timer
+	order  int
+}
+
+// newScheduler creates a scheduler without timers
+func newScheduler() *scheduler {
+	return &scheduler{timers: make(map[string]*timer)}
+}
+
+// schedule starts or replaces a timer, frames keep their pace when started twice
+func (s *scheduler) schedule(msg scheduleMsg, now time.Time) {
+	if existing, ok := s.timers[msg.name]; ok && msg.frames && existing.frames {
+		return
+	}
+
+	s.order++
+	s.timers[msg.name] = &timer{
+		name:     msg.name,
+		deadline: now.Add(msg.delay),
+		interval: msg.interval,
+		frames:   msg.frames,
+		order:    s.order,
+	}
+}
+
+// cancel removes the named timer
+func (s *scheduler) cancel(name string) {
+	delete(s.timers, name)
+}
+
+// next returns the earliest timer, or nil when none is scheduled
+func (s *scheduler) next() *timer {
+	var earliest *timer
+	for _, t := range s.timers {
+		if earliest == nil || t.deadline.Before(earliest.deadline) ||
+			(t.deadline.Equal(earliest.deadline) && t.order < earliest.order) {
+			earliest = t
+		}
+	}
+	return earliest
+}
+
+// nextDeadline returns when the earliest timer fires
+func (s *scheduler) nextDeadline() (time.Time, bool) {
+	t := s.next()
+	if t == nil {
+		return time.Time{}, false
+	}
+	return t.deadline, true
+}
+
+// popDue fires the earliest timer due at or before now and returns its message
+func (s *scheduler) popDue(now time.Time) (Msg, bool) {
+	t := s.next()
+	if t == nil || t.deadline.After(now) {
+		return nil, false
+	}
+
+	var msg Msg
+	if t.frames {
+		msg = FrameMsg{Time: t.deadline, Delta: t.interval}
+	} else {
+		msg = TimerMsg{Name: t.name, Time: t.deadline}
+	}
+
+	if t.interval <= 0 {
+		delete(s.timers, t.name)
+		return msg, true
+	}
+
+	t.deadline = t.deadline.Add(t.interval)
+	// Drop the frames of a long stall instead of replaying all of them
+	if now.Sub(t.deadline) > maxFrameCatchUp*t.interval {
+		t.deadline = now.Add(t.interval)
+	}
+	return msg, true
+}
@@ -12,8 +12,13 @@ type Model interface {
 	View() string
 }
 
+// KeyMsg is a decoded key press.
+// Rune keeps the legacy encoding (arrows as '↑', Enter as '\r', Esc as 27) so rune matching still works.
 type KeyMsg struct {
 	Rune rune
+	Type KeyType
+	Mod  KeyMod
+	Text string // Pasted content when Type is KeyPaste
 }
 
 type QuitMsg struct{}
@@ -29,17 +34,19 @@ type TickMsg struct {
 }
 
 // Tick is a command that sends a TickMsg after a specified duration.
+// The delay is measured on the global clock so headless runs can use virtual time.
 func Tick(d time.Duration) Cmd {
 	return func() Msg {
-		time.Sleep(d)
-		return TickMsg{Time: time.Now()}
+		clock := GetGlobalClock()
+		clock.Sleep(d)
+		return TickMsg{Time: clock.Now()}
 	}
 }
 
 // TickNow returns a Tick command that fires immediately
 func TickNow() Cmd {
 	return func() Msg {
-		return TickMsg{Time: time.Now()}
+		return TickMsg{Time: GetGlobalClock().Now()}
 	}
 }
 
@@ -7,33 +7,56 @@ import (
 )
 
 type Enemy struct {
-	EnemyState types.CombatState
-	Name       string
-	Force      int
-	Speed      int
-	Defense    int
-	Accuracy   int
-	MaxHP      int
-	CurrentHP  int
-	ExpReward  int
-	Sprite     string
-	Position   types.Position
-	IsAlive    bool
+	SpawnID     int    // Index of the enemy in its stage spawn list
+	Archetype   string // Key of the archetype the enemy was spawned from, empty for inline enemies
+	EnemyState  types.CombatState
+	Name        string
+	Force       int
+	Speed       int
+	Defense     int
+	Accuracy    int
+	MaxHP       int
+	CurrentHP   int
+	ExpReward   int
+	Credits     int
+	Resistances map[types.DamageType]int // Percent of damage of each type ignored, negative for weaknesses
+	Statuses    types.StatusList         // Buffs and debuffs of the current fight
+	Loot        types.LootTable
+	AI          types.AIProfile // Weighted actions picked on its combat turns
+	Sprite      string
+	Position    types.Position
+	IsAlive     bool
+
+	// Exploration AI
+	Home     types.Position   // Post the enemy returns to when it has no patrol
+	Patrol   []types.Position // Waypoints walked in a loop
+	Waypoint int              // Index of the next patrol waypoint
+	Sight    int              // Distance at which it notices the player
+	Alert    types.AlertState
 }
 
 func NewEnemy(e Enemy) *Enemy {
 	return &Enemy{
-		Name:      e.Name,
-		Force:     e.Force,
-		Speed:     e.Speed,
-		Defense:   e.Defense,
-		Accuracy:  e.Accuracy,
-		MaxHP:     e.MaxHP,
-		CurrentHP: e.CurrentHP,
-		ExpReward: e.ExpReward,
-		Sprite:    e.Sprite,
-		Position:  e.Position,
-		IsAlive:   true,
+		SpawnID:     e.SpawnID,
+		Archetype:   e.Archetype,
+		Name:        e.Name,
+		Force:       e.Force,
+		Speed:       e.Speed,
+		Defense:     e.Defense,
+		Accuracy:    e.Accuracy,
+		MaxHP:       e.MaxHP,
+		CurrentHP:   e.CurrentHP,
+		ExpReward:   e.ExpReward,
+		Credits:     e.Credits,
+		Resistances: e.Resistances,
+		Loot:        e.Loot,
+		AI:          e.AI,
+		Sprite:      e.Sprite,
+		Position:    e.Position,
+		IsAlive:     true,
+		Home:        e.Position,
+		Patrol:      e.Patrol,
+		Sight:       e.Sight,
 	}
 }
 
@@ -51,7 +74,7 @@ func (e *Enemy) GetStats() types.EnemyStats {
 // TakeDamage applies damage to the enemy and returns true if the enemy is defeated
 func (e *Enemy) TakeDamage(damage int) bool {
 	e.CurrentHP -= damage
-	if e.CurrentHP < 0 {
+	if e.CurrentHP <= 0 {
 		e.CurrentHP = 0
 		e.IsAlive = false
 		return true
@@ -0,0 +1,59 @@
+// Package events provides the publish/subscribe bus game systems use to react to each other.
+//
+// Systems publish what happened without knowing who listens, and subscribers
+// register once for the kinds of events they care about:
+//
+//	bus := events.NewBus()
+//	bus.OnEnemyDefeated(func(e events.EnemyDefeated) { quests.EnemyDefeated(e.Enemy.Archetype) })
+//	bus.Publish(events.EnemyDefeated{Enemy: enemy})
+//
+// Dispatch is synchronous: Publish returns once every handler ran. Handlers run
+// in subscription order, and events published by a handler are queued and
+// dispatched after the current event, so the order never depends on timing.
+package events
+
+// Handler reacts to a published event
+type Handler func(Event)
+
+// Bus dispatches published events to the handlers subscribed to their kind.
+// A nil bus ignores both subscriptions and events, so systems built without one stay silent.
+type Bus struct {
+	handlers    map[Kind][]Handler
+	queue       []Event // Events published while dispatching, in publication order
+	dispatching bool
+}
+
+// NewBus creates a bus without subscribers
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[Kind][]Handler)}
+}
+
+// Subscribe registers a handler for every event of a kind, after the handlers already registered
+func (b *Bus) Subscribe(kind Kind, handler Handler) {
+	if b == nil {
+		return
+	}
+	b.handlers[kind] = append(b.handlers[kind], handler)
+}
+
+// Publish runs the handlers of an event before returning.
+// An event published by a handler waits for the handlers of the current one to finish.
+func (b *Bus) Publish(event Event) {
+	if b == nil {
+		return
+	}
+	b.queue = append(b.queue, event)
+	if b.dispatching {
+		return
+	}
+
+	b.dispatching = true
+	defer func() { b.dispatching = false }()
+	for len(b.queue) > 0 {
+		next := b.queue[0]
+		b.queue = b.queue[1:]
+		for _, handler := range b.handlers[next.Kind()] {
+			handler(next)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package events
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNilBusIgnoresEverything(t *testing.T) {
+	var bus *Bus
+	bus.OnStageEntered(func(StageEntered) { t.Error("handler of a nil bus ran") })
+	bus.Publish(StageEntered{WorldID: 1, StageNb: 1})
+}
+
+func TestPublishQueuesNestedEvents(t *testing.T) {
+	bus := NewBus()
+	var got []string
+	bus.OnStageEntered(func(StageEntered) {
+		got = append(got, "stage")
+		bus.Publish(IntroEnded{})
+		got = append(got, "stage done")
+	})
+	bus.OnStageEntered(func(StageEntered) { got = append(got, "stage second") })
+	bus.OnIntroEnded(func(IntroEnded) { got = append(got, "intro") })
+
+	bus.Publish(StageEntered{})
+	want := []string{"stage", "stage done", "stage second", "intro"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("handlers ran in order %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,115 @@
+package events
+
+import (
+	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/types"
+)
+
+// Kind identifies the type of an event
+type Kind int
+
+const (
+	KindEnemyDefeated Kind = iota
+	KindPlayerLeveledUp
+	KindItemAcquired
+	KindStageEntered
+	KindIntroEnded
+	KindDialogueEnded
+	KindCombatStarted
+	KindCombatEnded
+)
+
+// Event is something that happened in the game
+type Event interface {
+	Kind() Kind
+}
+
+// EnemyDefeated is published by combat each time an enemy is downed
+type EnemyDefeated struct {
+	Enemy *entities.Enemy
+}
+
+// PlayerLeveledUp is published when experience makes the player reach a new level
+type PlayerLeveledUp struct {
+	Player *types.Player
+	Level  int // Level reached
+}
+
+// ItemAcquired is published when an item enters the inventory of the player
+type ItemAcquired struct {
+	Player *types.Player
+	Item   types.Item
+}
+
+// StageEntered is published once a stage becomes the current one
+type StageEntered struct {
+	WorldID int
+	StageNb int
+}
+
+// IntroEnded is published when the player went through the introduction of a stage
+type IntroEnded struct{}
+
+// DialogueEnded is published when a dialogue closes, whether it ran out of nodes or an action ended it
+type DialogueEnded struct {
+	Dialogue string // Key of the dialogue graph, empty for dialog sequences
+}
+
+// CombatStarted is published when a fight begins
+type CombatStarted struct {
+	Enemies []*entities.Enemy
+}
+
+// CombatEnded is published when the player leaves a fight
+type CombatEnded struct {
+	Victory bool
+}
+
+func (EnemyDefeated) Kind() Kind   { return KindEnemyDefeated }
+func (PlayerLeveledUp) Kind() Kind { return KindPlayerLeveledUp }
+func (ItemAcquired) Kind() Kind    { return KindItemAcquired }
+func (StageEntered) Kind() Kind    { return KindStageEntered }
+func (IntroEnded) Kind() Kind      { return KindIntroEnded }
+func (DialogueEnded) Kind() Kind   { return KindDialogueEnded }
+func (CombatStarted) Kind() Kind   { return KindCombatStarted }
+func (CombatEnded) Kind() Kind     { return KindCombatEnded }
+
+// OnEnemyDefeated subscribes a handler to EnemyDefeated events
+func (b *Bus) OnEnemyDefeated(handler func(EnemyDefeated)) {
+	b.Subscribe(KindEnemyDefeated, func(e Event) { handler(e.(EnemyDefeated)) })
+}
+
+// OnPlayerLeveledUp subscribes a handler to PlayerLeveledUp events
+func (b *Bus) OnPlayerLeveledUp(handler func(PlayerLeveledUp)) {
+	b.Subscribe(KindPlayerLeveledUp, func(e Event) { handler(e.(PlayerLeveledUp)) })
+}
+
+// OnItemAcquired subscribes a handler to ItemAcquired events
+func (b *Bus) OnItemAcquired(handler func(ItemAcquired)) {
+	b.Subscribe(KindItemAcquired, func(e Event) { handler(e.(ItemAcquired)) })
+}
+
+// OnStageEntered subscribes a handler to StageEntered events
+func (b *Bus) OnStageEntered(handler func(StageEntered)) {
+	b.Subscribe(KindStageEntered, func(e Event) { handler(e.(StageEntered)) })
+}
+
+// OnIntroEnded subscribes a handler to IntroEnded events
+func (b *Bus) OnIntroEnded(handler func(IntroEnded)) {
+	b.Subscribe(KindIntroEnded, func(e Event) { handler(e.(IntroEnded)) })
+}
+
+// OnDialogueEnded subscribes a handler to DialogueEnded events
+func (b *Bus) OnDialogueEnded(handler func(DialogueEnded)) {
+	b.Subscribe(KindDialogueEnded, func(e Event) { handler(e.(DialogueEnded)) })
+}
+
+// OnCombatStarted subscribes a handler to CombatStarted events
+func (b *Bus) OnCombatStarted(handler func(CombatStarted)) {
+	b.Subscribe(KindCombatStarted, func(e Event) { handler(e.(CombatStarted)) })
+}
+
+// OnCombatEnded subscribes a handler to CombatEnded events
+func (b *Bus) OnCombatEnded(handler func(CombatEnded)) {
+	b.Subscribe(KindCombatEnded, func(e Event) { handler(e.(CombatEnded)) })
+}
@@ -19,8 +19,10 @@ package game
 import (
 	"fmt"
 
+	"projectred-rpg.com/config"
 	"projectred-rpg.com/engine"
 	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/events"
 	"projectred-rpg.com/game/loaders"
 	"projectred-rpg.com/game/systems"
 	"projectred-rpg.com/game/types"
@@ -40,12 +42,15 @@ type Game struct {
 	// Game systems - modular components handling specific game logic
 	//Combat    *systems.CombatSystem    // Handles damage calculations and battle mechanics
 	Inventory  *systems.InventorySystem  // Manages item operations and equipment
+	Merchant   *systems.MerchantSystem   // Handles buying from and selling to merchants
+	Implants   *systems.ImplantSystem    // Installs and removes the player's implants
 	Movement   *systems.MovementSystem   // Processes player movement and collision detection
 	LevelIntro *systems.LevelIntroSystem // Handles level introduction dialogues
 
 	// Game state
 	language     string
 	pendingStage *types.Stage // Stage to load after intro completes
+	events       *events.Bus  // Where entered stages are published
 }
 
 // NewGameInstance creates a new game with the specified character class.
@@ -57,6 +62,8 @@ type Game struct {
 // Parameters:
 //
 //	selectedClass: The character class that determines base stats and abilities
+//	language: Current language used for level introductions
+//	bus: Event bus the game systems publish on
 //
 // Returns:
 //
@@ -65,8 +72,8 @@ type Game struct {
 // Example:
 //
 //	class := config.DefaultClasses["CYBER_SAMURAI"]
-//	game := NewGameInstance(class)
-func NewGameInstance(selectedClass types.Class, language string) *Game {
+//	game := NewGameInstance(class, "en", events.NewBus())
+func NewGameInstance(selectedClass types.Class, language string, bus *events.Bus) *Game {
 	world := NewWorld(1)
 	spawn := types.Position{X: 1, Y: 1}
 	if len(world.Stages) > 0 && (world.Stages[0].PlayerSpawn != (types.Position{})) {
@@ -74,21 +81,65 @@ func NewGameInstance(selectedClass types.Class, language string) *Game {
 	}
 	player := entities.NewPlayer("Sam", selectedClass, spawn)
 
+	// Consumable data is needed both for the starting items and in combat
+	if err := loaders.LoadConsumables(); err == nil {
+		for _, start := range config.GetStartingItems() {
+			if item, ok := loaders.NewConsumableItem(start.Key, start.Quantity); ok {
+				player.AddItemToInventory(item)
+			}
+		}
+	}
+
+	if weapon, ok := loaders.NewWeaponItem(config.StartingWeapon); ok {
+		player.Weapon = &weapon
+	}
+
 	// Create level intro system
-	levelIntro := systems.NewLevelIntroSystem(language)
+	levelIntro := systems.NewLevelIntroSystem(language, bus)
 	if err := levelIntro.LoadLocalization(); err != nil {
 		panic(fmt.Sprintf("Failed to load localization for language '%s': %v", language, err))
 	}
 
+	inventory := systems.NewInventorySystem(bus)
 	return &Game{
 		Player:       player,
 		CurrentWorld: world,
 		CurrentStage: &world.Stages[0],
-		Inventory:    systems.NewInventorySystem(),
+		Inventory:    inventory,
+		Merchant:     systems.NewMerchantSystem(inventory),
+		Implants:     systems.NewImplantSystem(),
 		Movement:     systems.NewMovementSystem(),
 		LevelIntro:   levelIntro, // AJOUTEZ CETTE LIGNE
 		language:     language,
+		events:       bus,
+	}
+}
+
+// NewGameFromSave rebuilds a game from a save file.
+// The player, world and stage are restored as saved; the stage intro is not replayed.
+//
+// Parameters:
+//
+//	data: The save data returned by SaveSystem.Load
+//	language: Current language used for level introductions
+//	bus: Event bus the game systems publish on
+//
+// Returns:
+//
+//	*Game: Game instance positioned on the saved stage
+func NewGameFromSave(data *systems.SaveData, language string, bus *events.Bus) *Game {
+	g := NewGameInstance(data.Player.Class, language, bus)
+
+	player := data.Player
+	g.Player = &player
+
+	world := NewWorld(data.WorldID)
+	if stage := world.GetStage(data.StageNb); stage != nil {
+		g.CurrentWorld = world
+		g.CurrentStage = stage
 	}
+
+	return g
 }
 
 // NewWorld loads or creates a world by its ID.
@@ -167,11 +218,8 @@ func (g *Game) LoadStage(worldID, stageID int) {
 		return // Stage not found
 	}
 
-	// Try to show intro
-	if g.LevelIntro.ShowIntro(filename, 80, 24, func() {
-		// Callback when intro is complete - actually load the stage
-		g.actuallyLoadStage(targetStage)
-	}) {
+	// Try to show intro, FinishIntro loads the stage once it is over
+	if g.LevelIntro.ShowIntro(filename, 80, 24) {
 		// Intro found, store the stage to load after intro
 		g.pendingStage = targetStage
 	} else {
@@ -180,11 +228,23 @@ func (g *Game) LoadStage(worldID, stageID int) {
 	}
 }
 
-// actuallyLoadStage performs the actual stage loading
+// FinishIntro loads the stage waiting for its introduction to end
+func (g *Game) FinishIntro() {
+	if g.pendingStage != nil {
+		g.actuallyLoadStage(g.pendingStage)
+	}
+}
+
+// ResumeStage enters the current stage again without its introduction, as when a save is loaded
+func (g *Game) ResumeStage() {
+	g.actuallyLoadStage(g.CurrentStage)
+}
+
+// actuallyLoadStage performs the actual stage loading and publishes StageEntered
 func (g *Game) actuallyLoadStage(stage *types.Stage) {
 	g.CurrentStage = stage
 	g.pendingStage = nil
-	// Add any other stage loading logic here
+	g.events.Publish(events.StageEntered{WorldID: stage.WorldID, StageNb: stage.StageNb})
 }
 
 // IsShowingIntro returns whether an intro is currently being shown
@@ -0,0 +1,159 @@
+package game
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/loaders"
+	"projectred-rpg.com/game/systems"
+	"projectred-rpg.com/game/types"
+)
+
+// dialogueTypeTimer is the name of the timer typing the dialogue text
+const dialogueTypeTimer = "dialogue-typing"
+
+// dialogueContext lets the dialogue graph of an NPC read and change the game
+type dialogueContext struct {
+	gr  *GameRender
+	npc *types.NPC
+}
+
+// Player returns the player the conditions are checked against
+func (dc dialogueContext) Player() *types.Player {
+	return dc.gr.gameInstance.Player
+}
+
+// QuestState returns where the player stands in a quest
+func (dc dialogueContext) QuestState(id string) types.QuestState {
+	return dc.gr.questSystem.State(id)
+}
+
+// StartQuest makes a quest active
+func (dc dialogueContext) StartQuest(id string) {
+	dc.gr.startQuest(id)
+}
+
+// GiveItem adds an item to the inventory, dropping it at the player's feet when it is full
+func (dc dialogueContext) GiveItem(key string, quantity int) {
+	item, ok := loaders.LootItem(types.LootEntry{Item: key, Quantity: quantity})
+	if !ok {
+		return
+	}
+
+	locManager := engine.GetLocalizationManager()
+	name := itemNames(locManager, []types.Item{item})[0]
+	dc.gr.announceItems(func() string {
+		if !dc.gr.giveOrDrop(item) {
+			return locManager.Text("ui.hud.received_dropped", name)
+		}
+		return locManager.Text("ui.hud.received", name)
+	})
+}
+
+// StartCombat fights an enemy of the archetype appearing where the NPC stands, once the dialogue it ends is left
+func (dc dialogueContext) StartCombat(enemy string) {
+	spawned, ok := dc.gr.spawnerSystem.Spawn(enemy, dc.npc.Pos)
+	if !ok {
+		return
+	}
+	dc.gr.combatSystem.EnterCombat([]*entities.Enemy{spawned}, dc.gr.gameInstance.Player)
+}
+
+// OpenShop opens the catalog of a merchant
+func (dc dialogueContext) OpenShop(shop string) {
+	dc.gr.openShop(shop)
+}
+
+// startDialogue walks the dialogue graph of an NPC
+func (gr *GameRender) startDialogue(npc *types.NPC, graph types.DialogueGraph) engine.Cmd {
+	gr.npcSystem.StartDialogue(npc, graph, dialogueContext{gr: gr, npc: npc})
+	return gr.showDialogue()
+}
+
+// showDialogue switches to the dialogue started by the NPC system and starts typing its text
+func (gr *GameRender) showDialogue() engine.Cmd {
+	// The first nodes may already have ended the dialogue with an action
+	if !gr.dialogSystem.IsActive() {
+		return gr.leaveDialogue()
+	}
+	gr.gameState.ChangeState(systems.StateDialogue)
+	return engine.Every(dialogueTypeTimer, config.DialogueTypeInterval)
+}
+
+// leaveDialogue goes back to exploration once a dialogue ends, unless an action opened a shop or started a fight
+func (gr *GameRender) leaveDialogue() engine.Cmd {
+	if gr.combatSystem.IsInCombat() {
+		return gr.enterCombat()
+	}
+	if gr.gameState.CurrentState == systems.StateDialogue {
+		gr.gameState.ChangeState(systems.StateExploration)
+	}
+	return nil
+}
+
+// handleDialogueTyping types the next letter of the dialogue text, the timer stops once it is complete
+func (gr *GameRender) handleDialogueTyping(msg engine.TimerMsg) (engine.Model, engine.Cmd) {
+	if gr.gameState.CurrentState != systems.StateDialogue || !gr.dialogSystem.IsTyping() {
+		return gr, engine.Cancel(dialogueTypeTimer)
+	}
+	gr.dialogSystem.Update(engine.TickMsg{Time: msg.Time})
+	return gr, nil
+}
+
+// renderDialogueView draws the dialogue box over the exploration view, next to the NPC who speaks
+func (gr *GameRender) renderDialogueView() string {
+	view := gr.renderGameView()
+	box := gr.dialogSystem.Render()
+	if box == "" {
+		return view
+	}
+
+	lines := strings.Split(view, "\n")
+	boxLines := strings.Split(box, "\n")
+	boxWidth := lipgloss.Width(box)
+	hudLines := strings.Count(gr.hud.View(), "\n") + 1
+	contentLines := max(len(lines)-hudLines, 0)
+
+	// Without a speaker in sight the box sits at the bottom of the exploration view
+	top := max(contentLines-len(boxLines), 0)
+	left := max((gr.screenWidth-boxWidth)/2, 0)
+	if npc := gr.npcSystem.GetCurrentInteraction(); npc != nil {
+		if x, y, ok := gr.gameSpace.screenPosition(npc.Pos); ok {
+			// The exploration view is centered above the HUD
+			x += (gr.screenWidth - gr.gameSpace.width) / 2
+			y += (contentLines - gr.gameSpace.height) / 2
+			spriteHeight := strings.Count(npc.Sprite, "\n") + 1
+
+			// Above the NPC when there is room, otherwise below it
+			switch {
+			case y-len(boxLines) >= 0:
+				top = y - len(boxLines)
+			case y+spriteHeight+len(boxLines) <= contentLines:
+				top = y + spriteHeight
+			}
+			left = min(max(x+lipgloss.Width(npc.Sprite)/2-boxWidth/2, 0), max(gr.screenWidth-boxWidth, 0))
+		}
+	}
+
+	for i, line := range boxLines {
+		if top+i >= len(lines) {
+			break
+		}
+		lines[top+i] = overlayLine(lines[top+i], line, left)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// overlayLine draws over on top of line from the column left, the rest of line stays visible
+func overlayLine(line, over string, left int) string {
+	prefix := ansi.Truncate(line, left, "")
+	if pad := left - ansi.StringWidth(prefix); pad > 0 {
+		prefix += strings.Repeat(" ", pad)
+	}
+	suffix := ansi.TruncateLeft(line, left+ansi.StringWidth(over), "")
+	return prefix + ansi.ResetStyle + over + ansi.ResetStyle + suffix
+}
@@ -0,0 +1,69 @@
+package game
+
+import (
+	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/events"
+	"projectred-rpg.com/game/systems"
+)
+
+// subscribeEvents makes the renderer, the HUD and the quests react to what the game systems publish
+func (gr *GameRender) subscribeEvents() {
+	gr.events.OnStageEntered(func(events.StageEntered) {
+		gr.forceStageReload()
+	})
+	gr.events.OnIntroEnded(func(events.IntroEnded) {
+		gr.gameInstance.FinishIntro()
+	})
+	gr.events.OnCombatStarted(func(events.CombatStarted) {
+		if gr.gameSpace != nil {
+			gr.gameSpace.SetStatus("")
+		}
+	})
+	gr.events.OnCombatEnded(func(events.CombatEnded) {
+		gr.leaveCombat()
+	})
+	gr.events.OnEnemyDefeated(func(e events.EnemyDefeated) {
+		gr.onEnemyDefeated(e.Enemy)
+	})
+	gr.events.OnItemAcquired(func(events.ItemAcquired) {
+		gr.trackItems()
+	})
+	gr.events.OnPlayerLeveledUp(func(e events.PlayerLeveledUp) {
+		if gr.gameSpace != nil {
+			gr.gameSpace.AddStatus(engine.GetLocalizationManager().Text("ui.hud.level_up", e.Level))
+		}
+	})
+}
+
+// enterCombat switches to the fight the combat system just started and runs it on fixed frames
+func (gr *GameRender) enterCombat() engine.Cmd {
+	gr.gameState.ChangeState(systems.StateCombat)
+	return engine.StartFrames()
+}
+
+// leaveCombat brings the player back to exploration after any fight: victory, defeat, escape or forced exit
+func (gr *GameRender) leaveCombat() {
+	if gr.gameInstance != nil && gr.gameInstance.Player != nil && gr.gameInstance.Player.Stats.CurrentHP <= 0 {
+		gr.handlePlayerDefeat()
+	}
+
+	gr.gameState.ChangeState(systems.StateExploration)
+	// Enemies wait before engaging again, otherwise the same fight restarts on the next step
+	gr.enemyAI.Rest()
+	gr.refreshEnemies()
+}
+
+// refreshEnemies removes the defeated enemies from the stage and the viewport
+func (gr *GameRender) refreshEnemies() {
+	if gr.gameSpace == nil {
+		return
+	}
+	// Clean up defeated enemies first
+	gr.gameSpace.RemoveDeadEnemies()
+
+	// Always refresh with the latest spawner data
+	if gr.spawnerSystem != nil {
+		gr.spawnerSystem.RemoveDefeatedEnemies()
+		gr.gameSpace.ForceRefreshEnemies(gr.spawnerSystem.GetActiveEnemies())
+	}
+}
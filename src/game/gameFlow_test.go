@@ -0,0 +1,128 @@
+package game
+
+import (
+	"flag"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/systems"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden frames in testdata")
+
+// flowStep is a checkpoint of the scripted playthrough, its script runs after the ones before it
+type flowStep struct {
+	golden string
+	script func(s *engine.Script)
+}
+
+// flowSteps goes from the main menu to the first fight of the game
+var flowSteps = []flowStep{
+	{"menu", func(s *engine.Script) {
+		s.Size(140, 45).Wait(time.Second)
+	}},
+	{"class_selection", func(s *engine.Script) {
+		s.Key('\r').Wait(time.Second)
+	}},
+	{"exploration", func(s *engine.Script) {
+		s.Key('\r').Wait(time.Second)
+		for range 10 {
+			s.Key('\r').Wait(time.Second)
+		}
+	}},
+	{"combat", func(s *engine.Script) {
+		for _, r := range strings.Repeat("→", 8) + strings.Repeat("↑", 15) + strings.Repeat("→", 20) {
+			s.Key(r).Wait(100 * time.Millisecond)
+		}
+		s.Wait(time.Second)
+	}},
+}
+
+// runFlow plays the scripts of the first steps checkpoints on a new game and returns the last frame without styles
+func runFlow(t *testing.T, steps int) string {
+	t.Helper()
+
+	script := engine.NewScript()
+	for _, step := range flowSteps[:steps] {
+		step.script(script)
+	}
+	_, frame := playScript(t, script)
+	return frame
+}
+
+// playScript runs script on a new game with a seeded combat and returns the game and its last frame without styles
+func playScript(t *testing.T, script *engine.Script) (*GameRender, string) {
+	t.Helper()
+
+	gr := GameModel()
+	gr.saveSystem = systems.NewSaveSystem(t.TempDir(), config.SaveSlots)
+	gr.mainMenu = InitMainMenu(gr.locManager, false)
+	gr.combatSystem.SetRNG(rand.New(rand.NewSource(1)))
+
+	renderer := engine.NewMemoryRenderer()
+	clock := engine.NewVirtualClock(time.Date(2077, time.January, 1, 12, 0, 0, 0, time.UTC))
+	program := engine.NewProgram(engine.Wrap(gr), engine.WithHeadless(script), engine.WithRenderer(renderer), engine.WithClock(clock))
+	if err := program.Run(); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	lines := strings.Split(ansi.Strip(renderer.LastFrame()), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return gr, strings.Join(lines, "\n")
+}
+
+// TestGoldenFlow compares the frames of the menu, class selection, exploration and combat with testdata
+func TestGoldenFlow(t *testing.T) {
+	// Assets are read relative to the source root
+	t.Chdir("..")
+
+	for i, step := range flowSteps {
+		t.Run(step.golden, func(t *testing.T) {
+			frame := runFlow(t, i+1)
+			path := filepath.Join("game", "testdata", step.golden+".golden")
+			if *update {
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(frame), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("read golden frame: %v", err)
+			}
+			if frame != string(want) {
+				t.Errorf("frame differs from %s:\n%s", path, frame)
+			}
+		})
+	}
+}
+
+// TestLeavingCombatRestsEnemies checks a forced combat exit returns to exploration without the fight restarting
+func TestLeavingCombatRestsEnemies(t *testing.T) {
+	t.Chdir("..")
+
+	script := engine.NewScript()
+	for _, step := range flowSteps {
+		step.script(script)
+	}
+	script.Key('q').Wait(time.Second)
+	script.Key('←').Wait(time.Second)
+
+	gr, frame := playScript(t, script)
+	if gr.gameState.CurrentState != systems.StateExploration {
+		t.Fatalf("state is %v after leaving combat and stepping, want exploration:\n%s", gr.gameState.CurrentState, frame)
+	}
+}
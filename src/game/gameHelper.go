@@ -1,17 +1,27 @@
 package game
 
 import (
+	"fmt"
+	"strings"
+
 	"projectred-rpg.com/config"
 	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/loaders"
 	"projectred-rpg.com/game/systems"
+	"projectred-rpg.com/game/types"
 )
 
+// npcTalkDistance is how many tiles away from an NPC the player can talk to them
+const npcTalkDistance = 4
+
+// pickupDistance is how many tiles away from their sprite the player reaches items and chests
+const pickupDistance = 2
+
 func (gr *GameRender) refreshMenusAfterLanguageChange() {
 	locManager := engine.GetLocalizationManager()
 	sizeMsg := engine.SizeMsg{Width: gr.screenWidth, Height: gr.screenHeight}
 
-	gr.mainMenu = InitMainMenu(locManager)
-	gr.mainMenu, _ = gr.mainMenu.Update(sizeMsg)
+	gr.refreshMainMenu()
 
 	classes := config.GetDefaultClasses()
 	gr.classSelection = InitializeClassSelection(locManager, classes)
@@ -26,6 +36,12 @@ func (gr *GameRender) refreshMenusAfterLanguageChange() {
 
 	gr.merchantMenu = InitializeMerchantMenu(locManager)
 	gr.merchantMenu, _ = gr.merchantMenu.Update(sizeMsg)
+
+	gr.inventoryMenu = InitializeInventoryMenu(locManager)
+	gr.inventoryMenu, _ = gr.inventoryMenu.Update(sizeMsg)
+
+	gr.questLog = InitializeQuestLog(locManager)
+	gr.questLog, _ = gr.questLog.Update(sizeMsg)
 }
 
 func (gr *GameRender) handleSizeUpdate(msg engine.SizeMsg) {
@@ -37,6 +53,9 @@ func (gr *GameRender) handleSizeUpdate(msg engine.SizeMsg) {
 	gr.classSelection, _ = gr.classSelection.Update(msg)
 	gr.settingsMenu, _ = gr.settingsMenu.Update(msg)
 	gr.merchantMenu, _ = gr.merchantMenu.Update(msg)
+	gr.inventoryMenu, _ = gr.inventoryMenu.Update(msg)
+	gr.questLog, _ = gr.questLog.Update(msg)
+	gr.saveMenu, _ = gr.saveMenu.Update(msg)
 	*gr.hud, _ = gr.hud.Update(msg)
 
 	// Update combat UI if it exists
@@ -50,50 +69,35 @@ func (gr *GameRender) handleSizeUpdate(msg engine.SizeMsg) {
 	}
 }
 
-// updateGameSystems handles state-specific system updates for exploration and combat
+// updateGameSystems handles the exploration updates, combat exits are handled by the CombatEnded subscriber
 func (gr *GameRender) updateGameSystems() {
 	if gr.gameState.CurrentState == systems.StateExploration {
 		if gr.spawnerSystem != nil {
 			gr.spawnerSystem.RemoveDefeatedEnemies()
 
-			// Check if stage is cleared and activate transition zone
+			// Check if stage is cleared and activate the map exits
 			if gr.spawnerSystem.IsStageCleared() {
 				if gr.currentMap != nil {
-					gr.currentMap.ActivateTransitionZone()
+					gr.currentMap.ActivateTransitionZones(gr.questCompleted)
 				}
 
-				// Check if player is in transition zone
+				// Check if player stands in an exit
 				if gr.gameInstance != nil && gr.gameInstance.Player != nil && gr.currentMap != nil {
 					playerX := gr.gameInstance.Player.Pos.X
 					playerY := gr.gameInstance.Player.Pos.Y
 
-					if gr.currentMap.IsInTransitionZone(playerX, playerY) {
+					zone := gr.currentMap.TransitionZoneAt(playerX, playerY)
+					switch {
+					case zone == nil:
+						gr.declinedExit = nil
+					case zone != gr.declinedExit:
+						gr.pendingExit = zone
 						gr.gameState.ChangeState(systems.StateStageTransition)
 					}
 				}
 			}
 		}
 	}
-
-	if gr.gameState.CurrentState == systems.StateCombat {
-		if gr.combatSystem != nil && gr.gameInstance != nil && gr.gameInstance.Player != nil {
-			gr.combatSystem.Update(gr.gameInstance.Player)
-
-			if gr.combatSystem.IsReadyToExit() {
-				if gr.gameInstance.Player.Stats.CurrentHP <= 0 {
-					gr.handlePlayerDefeat()
-				}
-
-				gr.gameState.ChangeState(systems.StateExploration)
-
-				if gr.gameSpace != nil && gr.spawnerSystem != nil {
-					gr.spawnerSystem.RemoveDefeatedEnemies()
-					activeEnemies := gr.spawnerSystem.GetActiveEnemies()
-					gr.gameSpace.ForceRefreshEnemies(activeEnemies)
-				}
-			}
-		}
-	}
 }
 
 // updateHUDStats refreshes HUD with current player stats and location info
@@ -115,6 +119,7 @@ func (gr *GameRender) updateHUDStats() {
 		worldID,
 		stageID,
 	)
+	gr.hud.SetCredits(player.Credits)
 
 	if gr.gameInstance.CurrentWorld != nil && gr.gameInstance.CurrentStage != nil {
 		gr.hud.SetLocation(gr.gameInstance.CurrentWorld.Name, gr.gameInstance.CurrentStage.Name)
@@ -165,3 +170,211 @@ func abs(x int) int {
 	}
 	return x
 }
+
+// handleStepHazard reports damage taken from hazardous tiles and handles a resulting defeat
+func (gr *GameRender) handleStepHazard() {
+	hazard := gr.movement.TakeHazard()
+	if hazard == nil {
+		return
+	}
+
+	locManager := engine.GetLocalizationManager()
+	tile := string(hazard.Tile)
+	if hazard.Description != "" {
+		tile = locManager.Text(hazard.Description)
+	}
+	gr.gameSpace.SetStatus(locManager.Text("ui.hud.hazard", tile, hazard.Damage))
+
+	if gr.gameInstance.Player.Stats.CurrentHP <= 0 {
+		gr.handlePlayerDefeat()
+	}
+}
+
+// lookAround describes the notable tiles under and next to the player
+func (gr *GameRender) lookAround() {
+	if gr.gameInstance == nil || gr.gameInstance.Player == nil || gr.currentMap == nil {
+		return
+	}
+
+	locManager := engine.GetLocalizationManager()
+	player := gr.gameInstance.Player
+	seen := make(map[string]bool)
+	var descriptions []string
+
+	// Player footprint is 4x3, look one tile around it
+	for y := player.Pos.Y - 1; y <= player.Pos.Y+3; y++ {
+		for x := player.Pos.X - 1; x <= player.Pos.X+4; x++ {
+			_, props := gr.movement.TileInfo(gr.currentMap, x, y)
+			if props.Description == "" || seen[props.Description] {
+				continue
+			}
+			seen[props.Description] = true
+			descriptions = append(descriptions, locManager.Text(props.Description))
+		}
+	}
+
+	if len(descriptions) == 0 {
+		gr.gameSpace.SetStatus(locManager.Text("ui.hud.look_nothing"))
+		return
+	}
+	gr.gameSpace.SetStatus(locManager.Text("ui.hud.look", strings.Join(descriptions, ", ")))
+}
+
+// loadStageNPCs replaces the NPCs with the ones the spawner loaded for the stage, their sprites block movement
+func (gr *GameRender) loadStageNPCs(tm *types.TileMap) {
+	gr.npcSystem.Clear()
+	if tm != nil {
+		gr.spawnerSystem.ApplyNPCPlacements(tm.NPCs)
+	}
+
+	var blocked []types.Position
+	for _, npc := range gr.spawnerSystem.NPCs {
+		gr.npcSystem.AddNPC(npc)
+		blocked = append(blocked, npc.Tiles()...)
+	}
+	gr.movement.SetBlocked(blocked)
+	gr.gameSpace.SetNPCs(gr.spawnerSystem.NPCs)
+}
+
+// loadStageLoot shows the items left on the stage and its chests, the ones opened earlier stay open
+func (gr *GameRender) loadStageLoot(tm *types.TileMap, worldID, stageNb int) {
+	var chests []types.Chest
+	if tm != nil {
+		chests = tm.Chests
+	}
+	gr.lootSystem.LoadStage(worldID, stageNb, chests)
+}
+
+// showNearbyLoot tells the player what they can pick up or open
+func (gr *GameRender) showNearbyLoot() {
+	if gr.gameInstance == nil || gr.gameInstance.Player == nil {
+		return
+	}
+
+	locManager := engine.GetLocalizationManager()
+	player := gr.gameInstance.Player
+	if items := gr.lootSystem.ItemsInReach(player, pickupDistance); len(items) > 0 {
+		gr.gameSpace.SetStatus(locManager.Text("ui.hud.pickup", locManager.Text(items[0].Item.Name)))
+		return
+	}
+	if gr.lootSystem.ChestInReach(player, pickupDistance) != nil {
+		gr.gameSpace.SetStatus(locManager.Text("ui.hud.chest"))
+	}
+}
+
+// pickUpLoot opens the chest next to the player or picks up the items on the ground around them
+func (gr *GameRender) pickUpLoot() {
+	if gr.gameInstance == nil || gr.gameInstance.Player == nil {
+		return
+	}
+	gr.announceItems(gr.takeLoot)
+}
+
+// takeLoot empties the chest next to the player or the ground around them and returns what they got
+func (gr *GameRender) takeLoot() string {
+	locManager := engine.GetLocalizationManager()
+	player := gr.gameInstance.Player
+	inventory := gr.gameInstance.Inventory
+
+	if chest, items, ok := gr.lootSystem.OpenChest(player, inventory, pickupDistance); ok {
+		var found []string
+		if chest.Credits > 0 {
+			found = append(found, locManager.Text("ui.hud.credits_found", chest.Credits))
+		}
+		found = append(found, itemNames(locManager, items)...)
+		if len(found) == 0 {
+			return locManager.Text("ui.hud.chest_empty")
+		}
+		return locManager.Text("ui.hud.chest_opened", strings.Join(found, ", "))
+	}
+
+	left := gr.lootSystem.ItemsInReach(player, pickupDistance)
+	if len(left) == 0 {
+		return locManager.Text("ui.hud.nothing_to_pick")
+	}
+	picked, full := gr.lootSystem.PickUp(player, inventory, pickupDistance)
+	if full {
+		left = gr.lootSystem.ItemsInReach(player, pickupDistance)
+		return locManager.Text("ui.hud.inventory_full", locManager.Text(left[0].Item.Name))
+	}
+	return locManager.Text("ui.hud.picked", strings.Join(itemNames(locManager, picked), ", "))
+}
+
+// announceItems shows the message of give, which adds items to the inventory,
+// followed by the notices those items raised, such as the quests they completed
+func (gr *GameRender) announceItems(give func() string) {
+	gr.gameSpace.SetStatus("")
+	message := give()
+	notices := gr.gameSpace.Status()
+	gr.gameSpace.SetStatus(message)
+	if notices != "" {
+		gr.gameSpace.AddStatus(notices)
+	}
+}
+
+// itemNames returns the localized name of each item, with the stack size when there are several
+func itemNames(locManager *engine.LocalizationManager, items []types.Item) []string {
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = locManager.Text(item.Name)
+		if item.Count() > 1 {
+			names[i] = fmt.Sprintf("%s x%d", names[i], item.Count())
+		}
+	}
+	return names
+}
+
+// nearbyNPC returns the NPC the player is close enough to talk to
+func (gr *GameRender) nearbyNPC() *types.NPC {
+	if gr.gameInstance == nil || gr.gameInstance.Player == nil {
+		return nil
+	}
+	return gr.npcSystem.CheckInteractions(gr.gameInstance.Player.Pos, npcTalkDistance)
+}
+
+// showNearbyNPC tells the player who they can talk to
+func (gr *GameRender) showNearbyNPC() {
+	if npc := gr.nearbyNPC(); npc != nil {
+		locManager := engine.GetLocalizationManager()
+		gr.gameSpace.SetStatus(locManager.Text("ui.hud.talk", locManager.Text(npc.Name)))
+	}
+}
+
+// talkToNearbyNPC talks to the NPC next to the player.
+// NPCs with a dialogue graph start it, the others greet the player or open their shop.
+func (gr *GameRender) talkToNearbyNPC() engine.Cmd {
+	npc := gr.nearbyNPC()
+	if npc == nil {
+		gr.gameSpace.SetStatus(engine.GetLocalizationManager().Text("ui.hud.talk_nobody"))
+		return nil
+	}
+
+	gr.completeQuests(gr.questSystem.TalkedTo(npc.ID))
+	if npc.Dialogue != "" {
+		if graph, ok := loaders.GetDialogue(npc.Dialogue); ok {
+			return gr.startDialogue(npc, graph)
+		}
+	}
+	if shop := gr.npcSystem.TalkTo(npc, gr.gameInstance.Player.Name); shop != "" && !gr.openShop(shop) {
+		gr.npcSystem.EndInteraction()
+	}
+	if gr.dialogSystem.IsActive() {
+		return gr.showDialogue()
+	}
+	return nil
+}
+
+// equipNextWeapon cycles the equipped weapon through the weapons of the inventory
+func (gr *GameRender) equipNextWeapon() {
+	if gr.gameInstance == nil || gr.gameInstance.Player == nil {
+		return
+	}
+
+	locManager := engine.GetLocalizationManager()
+	player := gr.gameInstance.Player
+	if !gr.gameInstance.Inventory.EquipNextWeapon(player) {
+		gr.gameSpace.SetStatus(locManager.Text("ui.hud.no_weapon"))
+		return
+	}
+	gr.gameSpace.SetStatus(locManager.Text("ui.hud.equip", locManager.Text(player.Weapon.Name)))
+}
@@ -1,17 +1,32 @@
 package game
 
 import (
+	"strconv"
+
 	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/systems"
 	"projectred-rpg.com/game/types"
 	"projectred-rpg.com/ui"
 )
 
-func InitMainMenu(locManager *engine.LocalizationManager) ui.Menu {
-	menuOptions := []ui.MenuOption{
-		{Label: locManager.Text("ui.menu.start"), Value: "start"},
-		{Label: locManager.Text("ui.menu.settings"), Value: "settings"},
-		{Label: locManager.Text("ui.menu.quit"), Value: "quit"},
+// InitMainMenu builds the main menu, offering Continue and Load entries when saves exist
+func InitMainMenu(locManager *engine.LocalizationManager, hasSaves bool) ui.Menu {
+	menuOptions := []ui.MenuOption{}
+	if hasSaves {
+		menuOptions = append(menuOptions,
+			ui.MenuOption{Label: locManager.Text("ui.menu.continue"), Value: "continue"},
+		)
+	}
+	menuOptions = append(menuOptions, ui.MenuOption{Label: locManager.Text("ui.menu.start"), Value: "start"})
+	if hasSaves {
+		menuOptions = append(menuOptions,
+			ui.MenuOption{Label: locManager.Text("ui.menu.load"), Value: "load"},
+		)
 	}
+	menuOptions = append(menuOptions,
+		ui.MenuOption{Label: locManager.Text("ui.menu.settings"), Value: "settings"},
+		ui.MenuOption{Label: locManager.Text("ui.menu.quit"), Value: "quit"},
+	)
 	menu, err := ui.NewMenuWithArtFromFile(locManager.Text("ui.menu.mainmenu"), menuOptions, "assets/logo.txt")
 	if err != nil {
 		menu = ui.NewMenu("ui.menu.mainmenu", menuOptions)
@@ -19,6 +34,28 @@ func InitMainMenu(locManager *engine.LocalizationManager) ui.Menu {
 	return menu
 }
 
+// InitSaveSlotMenu builds the slot picker used both to save and to load a game
+func InitSaveSlotMenu(locManager *engine.LocalizationManager, title string, slots []systems.SaveSlotInfo) ui.Menu {
+	menuOptions := []ui.MenuOption{}
+	for _, slot := range slots {
+		label := locManager.Text("ui.save.empty", slot.Slot)
+		if slot.Exists {
+			label = locManager.Text("ui.save.slot",
+				slot.Slot,
+				slot.WorldID,
+				slot.StageNb,
+				slot.Level,
+				slot.SavedAt.Format("2006-01-02 15:04"),
+			)
+		}
+		menuOptions = append(menuOptions, ui.MenuOption{
+			Label: label,
+			Value: strconv.Itoa(slot.Slot),
+		})
+	}
+	return ui.NewMenu(title, menuOptions)
+}
+
 func InitializeClassSelection(locManager *engine.LocalizationManager, classes []types.Class) ui.ClassMenu {
 	menuOptions := []ui.ClassMenuOption{}
 	for _, class := range classes {
@@ -58,108 +95,17 @@ func InitializeSettingsSelection(locManager *engine.LocalizationManager, languag
 	return menu
 }
 
-func InitializeMerchantMenu(locManager *engine.LocalizationManager) ui.MerchantMenu {
-	menuOptions := []ui.MerchantMenuOption{
-		// === WEAPONS SECTION ===
-		{
-			Item: types.Item{
-				Name:        "═══ WEAPONS ═══",
-				Description: "",
-				Type:        types.Weapon,
-			},
-			Price: 0,
-		},
-		{
-			Item: types.Item{
-				Name:        "ui.weapons.katana.name",
-				Description: "ui.weapons.katana.description",
-				Type:        types.Weapon,
-			},
-			Price: 150,
-		},
-		{
-			Item: types.Item{
-				Name:        "ui.weapons.faux neuralink.name",
-				Description: "ui.weapons.faux neuralink.description",
-				Type:        types.Weapon,
-			},
-			Price: 200,
-		},
-		{
-			Item: types.Item{
-				Name:        "ui.weapons.arc synaptique.name",
-				Description: "ui.weapons.arc synaptique.description",
-				Type:        types.Weapon,
-			},
-			Price: 175,
-		},
-		{
-			Item: types.Item{
-				Name:        "ui.weapons.sniper.name",
-				Description: "ui.weapons.sniper.description",
-				Type:        types.Weapon,
-			},
-			Price: 250,
-		},
-		{
-			Item: types.Item{
-				Name:        "ui.weapons.neon reaver.name",
-				Description: "ui.weapons.neon reaver.description",
-				Type:        types.Weapon,
-			},
-			Price: 300,
-		},
-		{
-			Item: types.Item{
-				Name:        "═══ CONSUMABLE ═══",
-				Description: "",
-				Type:        types.Consumable,
-			},
-			Price: 0,
-		},
-		{
-			Item: types.Item{
-				Name:        "ui.consumable.small_medkit.name",
-				Description: "ui.consumable.small_medkit.description",
-				Type:        types.Consumable,
-			},
-			Price: 25,
-		},
-		{
-			Item: types.Item{
-				Name:        "ui.consumable.large_medkit.name",
-				Description: "ui.consumable.large_medkit.description",
-				Type:        types.Consumable,
-			},
-			Price: 50,
-		},
-		{
-			Item: types.Item{
-				Name:        "ui.consumable.money.name",
-				Description: "ui.consumable.money.description",
-				Type:        types.Consumable,
-			},
-			Price: 75,
-		},
-		{
-			Item: types.Item{
-				Name:        "ui.consumable.serum.name",
-				Description: "ui.consumable.serum.description",
-				Type:        types.Consumable,
-			},
-			Price: 100,
-		},
-		{
-			Item: types.Item{
-				Name:        "ui.consumable.flash.name",
-				Description: "ui.consumable.flash.description",
-				Type:        types.Consumable,
-			},
-			Price: 80,
-		},
-	}
+// InitializeInventoryMenu builds an empty inventory screen, it is filled with the player items when opened
+func InitializeInventoryMenu(locManager *engine.LocalizationManager) ui.InventoryMenu {
+	return ui.NewInventoryMenu("ui.inventory.title", locManager)
+}
 
-	merchantName := locManager.Text("game.merchants.weapon")
-	menu := ui.NewMerchantMenu(merchantName, menuOptions, locManager)
-	return menu
+// InitializeQuestLog builds an empty quest log, it is filled with the quests of the player when opened
+func InitializeQuestLog(locManager *engine.LocalizationManager) ui.QuestLog {
+	return ui.NewQuestLog("ui.quests.title", locManager)
+}
+
+// InitializeMerchantMenu builds an empty shop menu, it is filled with a catalog when a merchant is visited
+func InitializeMerchantMenu(locManager *engine.LocalizationManager) ui.MerchantMenu {
+	return ui.NewMerchantMenu("", nil, locManager)
 }
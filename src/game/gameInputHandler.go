@@ -1,8 +1,6 @@
 package game
 
 import (
-	"time"
-
 	"projectred-rpg.com/config"
 	"projectred-rpg.com/engine"
 	"projectred-rpg.com/game/systems"
@@ -20,16 +18,40 @@ func (gr *GameRender) handleGameInput(msg engine.KeyMsg) (engine.Model, engine.C
 	switch msg.Rune {
 	case '↑', '↓', '←', '→':
 		if gr.gameState.CurrentState == systems.StateExploration {
+			gr.gameSpace.SetStatus("")
 			_ = gr.movement.MovePlayer(gr.gameInstance.Player, msg.Rune, gr.currentMap)
+			gr.trackRegion()
+			gr.showNearbyLoot()
+			gr.showNearbyNPC()
+			gr.handleStepHazard()
 
 			if gr.combatSystem.TryEngageCombat(gr.gameInstance.Player) {
-				gr.gameState.ChangeState(systems.StateCombat)
-				return gr, engine.Tick(time.Second / 60) // Start combat tick loop
+				return gr, gr.enterCombat()
 			}
 		}
-	case 'm':
+	case 'e':
+		if gr.gameState.CurrentState == systems.StateExploration {
+			return gr, gr.talkToNearbyNPC()
+		}
+		return gr, nil
+	case 'g':
+		if gr.gameState.CurrentState == systems.StateExploration {
+			gr.pickUpLoot()
+		}
+		return gr, nil
+	case 'w':
 		if gr.gameState.CurrentState == systems.StateExploration {
-			gr.gameState.ChangeState(systems.StateMerchant)
+			gr.equipNextWeapon()
+		}
+		return gr, nil
+	case 'i':
+		if gr.gameState.CurrentState == systems.StateExploration {
+			gr.openInventory()
+		}
+		return gr, nil
+	case 'j':
+		if gr.gameState.CurrentState == systems.StateExploration {
+			gr.openQuestLog()
 		}
 		return gr, nil
 	case 'd':
@@ -37,9 +59,25 @@ func (gr *GameRender) handleGameInput(msg engine.KeyMsg) (engine.Model, engine.C
 			gr.gameState.ChangeState(systems.StateDebugMenu)
 		}
 		return gr, nil
+	case 's':
+		if gr.gameState.CurrentState == systems.StateExploration {
+			gr.openSaveMenu(saveModeSave)
+		}
+		return gr, nil
+	case 'l':
+		if gr.gameState.CurrentState == systems.StateExploration {
+			gr.lookAround()
+		}
+		return gr, nil
 	case 'p':
-		// Allow 'p' to trigger next stage/world in exploration
+		// Allow 'p' to trigger next stage/world in exploration, unless quests still lock the exits
 		if gr.gameState.CurrentState == systems.StateExploration {
+			if gr.exitsLocked() {
+				if gr.gameSpace != nil {
+					gr.gameSpace.SetStatus(engine.GetLocalizationManager().Text("ui.hud.exits_locked"))
+				}
+				return gr, nil
+			}
 			gr.gameState.ChangeState(systems.StateStageTransition)
 		}
 		return gr, nil
@@ -51,9 +89,13 @@ func (gr *GameRender) handleGameInput(msg engine.KeyMsg) (engine.Model, engine.C
 func (gr *GameRender) handleMerchantInput(msg engine.KeyMsg) (engine.Model, engine.Cmd) {
 	switch msg.Rune {
 	case '\r', '\n', ' ':
+		gr.tradeSelected()
+		return gr, nil
+	case engine.RuneTab:
+		gr.merchantMenu.SetSelling(!gr.merchantMenu.Selling)
 		return gr, nil
 	case 'q':
-		gr.gameState.ChangeState(systems.StateExploration)
+		gr.closeShop()
 		return gr, nil
 	default:
 		gr.merchantMenu, _ = gr.merchantMenu.Update(msg)
@@ -61,6 +103,43 @@ func (gr *GameRender) handleMerchantInput(msg engine.KeyMsg) (engine.Model, engi
 	return gr, nil
 }
 
+func (gr *GameRender) handleDialogueInput(msg engine.KeyMsg) (engine.Model, engine.Cmd) {
+	gr.dialogSystem.Update(msg)
+	if !gr.dialogSystem.IsActive() {
+		return gr, gr.leaveDialogue()
+	}
+	if gr.dialogSystem.IsTyping() {
+		return gr, engine.Every(dialogueTypeTimer, config.DialogueTypeInterval)
+	}
+	return gr, nil
+}
+
+func (gr *GameRender) handleInventoryInput(msg engine.KeyMsg) (engine.Model, engine.Cmd) {
+	switch msg.Rune {
+	case '\r', '\n', ' ':
+		gr.activateSelectedItem()
+	case 'x':
+		gr.dropSelectedItem()
+	case 's':
+		gr.sortInventory()
+	case 'q', 'i', engine.RuneEscape:
+		gr.closeInventory()
+	default:
+		gr.inventoryMenu, _ = gr.inventoryMenu.Update(msg)
+	}
+	return gr, nil
+}
+
+func (gr *GameRender) handleQuestLogInput(msg engine.KeyMsg) (engine.Model, engine.Cmd) {
+	switch msg.Rune {
+	case 'q', 'j', engine.RuneEscape:
+		gr.closeQuestLog()
+	default:
+		gr.questLog, _ = gr.questLog.Update(msg)
+	}
+	return gr, nil
+}
+
 func (gr *GameRender) handleClassSelectionInput(msg engine.KeyMsg) (engine.Model, engine.Cmd) {
 	switch msg.Rune {
 	case '\r', '\n', ' ': // Enter key
@@ -73,10 +152,13 @@ func (gr *GameRender) handleClassSelectionInput(msg engine.KeyMsg) (engine.Model
 					currentLang := engine.GetLocalizationManager().GetCurrentLanguage()
 
 					// Initialize game with selected class and language
-					gr.gameInstance = NewGameInstance(class, currentLang) // CORRIGÉ
+					gr.gameInstance = NewGameInstance(class, currentLang, gr.events)
 
 					gr.gameInstance.LoadStage(1, 1)
-					gr.forceStageReload() // Reset tracking to ensure stage loads
+					gr.lootSystem.Reset(nil)
+					gr.dialogSystem.RestoreFlags(nil)
+					gr.questSystem.Reset(nil)
+					gr.placeAtSpawn = true
 
 					gr.gameState.ChangeState(systems.StateExploration)
 					return gr, nil
@@ -102,6 +184,18 @@ func (gr *GameRender) handleMainMenuInput(msg engine.KeyMsg) (engine.Model, engi
 	case '\r', '\n', ' ': // Enter key
 		selected := gr.mainMenu.GetSelected()
 		switch selected.Value {
+		case "continue":
+			if slot, ok := gr.saveSystem.LatestSlot(); ok {
+				if err := gr.loadGame(slot); err != nil {
+					gr.openSaveMenu(saveModeLoad)
+				}
+			}
+			return gr, nil
+
+		case "load":
+			gr.openSaveMenu(saveModeLoad)
+			return gr, nil
+
 		case "start":
 			// Transition to class selection
 			gr.gameState.ChangeState(systems.StateClassSelection)
@@ -159,6 +253,10 @@ func (gr *GameRender) handleCombatInput(msg engine.KeyMsg) {
 	}
 
 	combatUI := gr.combatSystem.GetCombatUI()
+	if combatUI.ItemMenuOpen {
+		gr.handleCombatItemInput(msg)
+		return
+	}
 
 	switch msg.Rune {
 	case '↑':
@@ -171,17 +269,37 @@ func (gr *GameRender) handleCombatInput(msg engine.KeyMsg) {
 		if combatUI.SelectedAction < len(combatUI.AvailableActions)-1 {
 			combatUI.SelectedAction++
 		}
+	case '←':
+		gr.combatSystem.CycleTarget(-1)
+	case '→':
+		gr.combatSystem.CycleTarget(1)
 	case '\r', '\n', ' ': // Enter or Space - confirm action
 		if combatUI.SelectedAction >= 0 && combatUI.SelectedAction < len(combatUI.AvailableActions) {
+			// Running away exits the fight, which publishes CombatEnded
 			action := combatUI.AvailableActions[combatUI.SelectedAction]
-			success := gr.combatSystem.ProcessPlayerAction(action, gr.gameInstance.Player)
-			if action == "Run" && success {
-				// Successfully ran away, return to exploration
-				gr.gameState.ChangeState(systems.StateExploration)
-			}
+			gr.combatSystem.ProcessPlayerAction(action, gr.gameInstance.Player)
 		}
 	case 'q', 'Q': // Force quit combat (emergency exit)
 		gr.combatSystem.ExitCombat()
-		gr.gameState.ChangeState(systems.StateExploration)
+	}
+}
+
+// handleCombatItemInput handles input in the combat "Use Item" sub-menu
+func (gr *GameRender) handleCombatItemInput(msg engine.KeyMsg) {
+	combatUI := gr.combatSystem.GetCombatUI()
+
+	switch msg.Rune {
+	case '↑':
+		combatUI.MoveItemSelection(-1)
+	case '↓':
+		combatUI.MoveItemSelection(1)
+	case '\r', '\n', ' ':
+		index, ok := combatUI.SelectedInventoryIndex()
+		combatUI.CloseItemMenu()
+		if ok {
+			gr.combatSystem.UseItem(index, gr.gameInstance.Player)
+		}
+	case engine.RuneEscape, engine.RuneBackspace, 'q', 'Q':
+		combatUI.CloseItemMenu()
 	}
 }
@@ -0,0 +1,133 @@
+package game
+
+import (
+	"errors"
+
+	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/systems"
+	"projectred-rpg.com/game/types"
+)
+
+// inventoryOrders are the sort orders the inventory cycles through, with the localization key of their name
+var inventoryOrders = []struct {
+	order systems.InventoryOrder
+	name  string
+}{
+	{systems.OrderByType, "ui.inventory.order.type"},
+	{systems.OrderByName, "ui.inventory.order.name"},
+	{systems.OrderByValue, "ui.inventory.order.value"},
+}
+
+// openInventory shows the inventory screen from exploration
+func (gr *GameRender) openInventory() {
+	if gr.gameInstance == nil || gr.gameInstance.Player == nil {
+		return
+	}
+	gr.inventoryMenu.Reset()
+	gr.refreshInventory()
+	gr.gameState.ChangeState(systems.StateInventory)
+}
+
+// closeInventory goes back to exploration
+func (gr *GameRender) closeInventory() {
+	gr.gameState.ChangeState(systems.StateExploration)
+}
+
+// refreshInventory copies the player's items and equipped weapon into the inventory menu
+func (gr *GameRender) refreshInventory() {
+	player := gr.gameInstance.Player
+	gr.inventoryMenu.SetItems(player.Inventory, player.Weapon, player.MaxInv)
+}
+
+// activateSelectedItem uses the selected consumable or equips the selected weapon
+func (gr *GameRender) activateSelectedItem() {
+	index, ok := gr.inventoryMenu.SelectedIndex()
+	if !ok {
+		return
+	}
+
+	locManager := engine.GetLocalizationManager()
+	player := gr.gameInstance.Player
+	inventory := gr.gameInstance.Inventory
+	item := player.Inventory[index]
+	itemName := locManager.Text(item.Name)
+
+	if item.Type == types.Weapon {
+		if err := inventory.Equip(player, index); err != nil {
+			gr.inventoryMenu.Message = inventoryErrorText(locManager, err)
+		} else {
+			gr.inventoryMenu.Message = locManager.Text("ui.hud.equip", itemName)
+		}
+		gr.refreshInventory()
+		return
+	}
+
+	healed, err := inventory.UseItem(player, index)
+	if err != nil {
+		gr.inventoryMenu.Message = inventoryErrorText(locManager, err)
+	} else {
+		gr.inventoryMenu.Message = locManager.Text("ui.inventory.used", itemName, healed)
+	}
+	gr.refreshInventory()
+}
+
+// dropSelectedItem leaves the selected stack on the ground under the player
+func (gr *GameRender) dropSelectedItem() {
+	index, ok := gr.inventoryMenu.SelectedIndex()
+	if !ok {
+		return
+	}
+
+	player := gr.gameInstance.Player
+	item, err := gr.gameInstance.Inventory.Drop(player, index)
+	if err != nil {
+		return
+	}
+	gr.dropAtFeet(item)
+
+	locManager := engine.GetLocalizationManager()
+	gr.inventoryMenu.Message = locManager.Text("ui.inventory.dropped", itemNames(locManager, []types.Item{item})[0])
+	gr.refreshInventory()
+}
+
+// dropAtFeet leaves an item on the ground at the player's feet
+func (gr *GameRender) dropAtFeet(item types.Item) {
+	player := gr.gameInstance.Player
+	gr.lootSystem.Drop(item, types.Position{X: player.Pos.X + 1, Y: player.Pos.Y + 1})
+}
+
+// giveOrDrop adds an item to the inventory, dropping it at the player's feet when it is full.
+// It reports whether the item fit in the inventory.
+func (gr *GameRender) giveOrDrop(item types.Item) bool {
+	if gr.gameInstance.Inventory.AddItem(gr.gameInstance.Player, item) {
+		return true
+	}
+	gr.dropAtFeet(item)
+	return false
+}
+
+// sortInventory stacks identical items and sorts the inventory, each call uses the next order
+func (gr *GameRender) sortInventory() {
+	order := inventoryOrders[gr.inventorySort]
+	gr.inventorySort = (gr.inventorySort + 1) % len(inventoryOrders)
+
+	locManager := engine.GetLocalizationManager()
+	gr.gameInstance.Inventory.Sort(gr.gameInstance.Player, order.order, func(item types.Item) string {
+		return locManager.Text(item.Name)
+	})
+	gr.inventoryMenu.Order = order.name
+	gr.inventoryMenu.Message = ""
+	gr.refreshInventory()
+}
+
+// inventoryErrorText returns the localized message of a failed inventory action
+func inventoryErrorText(locManager *engine.LocalizationManager, err error) string {
+	switch {
+	case errors.Is(err, systems.ErrFullHealth):
+		return locManager.Text("ui.inventory.full_health")
+	case errors.Is(err, systems.ErrNotUsable):
+		return locManager.Text("ui.inventory.combat_only")
+	default:
+		return locManager.Text("ui.inventory.not_usable")
+	}
+}
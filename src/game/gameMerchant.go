@@ -0,0 +1,176 @@
+package game
+
+import (
+	"errors"
+
+	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/loaders"
+	"projectred-rpg.com/game/systems"
+	"projectred-rpg.com/game/types"
+	"projectred-rpg.com/ui"
+)
+
+// openShop shows the catalog of a merchant, restocked when it is first visited in the current world
+func (gr *GameRender) openShop(shopKey string) bool {
+	catalog, ok := loaders.GetMerchant(shopKey)
+	if !ok || gr.gameInstance == nil || gr.gameInstance.CurrentWorld == nil {
+		return false
+	}
+
+	gr.gameInstance.Merchant.Restock(catalog, gr.gameInstance.CurrentWorld.WorldID)
+	gr.shop = &catalog
+	gr.merchantMenu.Title = catalog.Name
+	gr.merchantMenu.Clinic = catalog.Clinic
+	gr.merchantMenu.SetSelling(false)
+	gr.refreshMerchant()
+	gr.gameState.ChangeState(systems.StateMerchant)
+	return true
+}
+
+// closeShop leaves the merchant and ends the interaction with them
+func (gr *GameRender) closeShop() {
+	gr.shop = nil
+	gr.npcSystem.EndInteraction()
+	gr.gameState.ChangeState(systems.StateExploration)
+}
+
+// refreshMerchant copies the shop stock, the player's credits and sellable items into the merchant menu
+func (gr *GameRender) refreshMerchant() {
+	if gr.gameInstance == nil || gr.gameInstance.Player == nil || gr.shop == nil {
+		return
+	}
+
+	merchant := gr.gameInstance.Merchant
+	var stock []ui.MerchantMenuOption
+	for i, section := range gr.shop.Sections {
+		for j, entry := range section.Items {
+			item, basePrice, ok := loaders.CatalogItem(entry)
+			if !ok {
+				continue
+			}
+			stock = append(stock, ui.MerchantMenuOption{
+				Item:    item,
+				Price:   gr.shop.Price(section, basePrice),
+				Section: section.Name,
+				Stock:   merchant.StockLeft(gr.shop.KeyName, i, j),
+				Ref:     [2]int{i, j},
+			})
+		}
+	}
+	gr.merchantMenu.Options = stock
+
+	player := gr.gameInstance.Player
+	gr.merchantMenu.Credits = player.Credits
+	if gr.shop.Clinic {
+		gr.merchantMenu.SetSellOptions(gr.implantOptions())
+		return
+	}
+
+	options := make([]ui.MerchantMenuOption, 0, len(player.Inventory))
+	for _, item := range player.Inventory {
+		options = append(options, ui.MerchantMenuOption{
+			Item:  item,
+			Price: merchant.SellPrice(item),
+		})
+	}
+	gr.merchantMenu.SetSellOptions(options)
+}
+
+// implantOptions lists the installed implants a clinic can remove, Ref holds their body slot
+func (gr *GameRender) implantOptions() []ui.MerchantMenuOption {
+	var options []ui.MerchantMenuOption
+	for slot, implant := range gr.gameInstance.Player.Implants {
+		if !implant.Installed() {
+			continue
+		}
+		item := implant.Item()
+		options = append(options, ui.MerchantMenuOption{
+			Item:  item,
+			Price: gr.gameInstance.Merchant.SellPrice(item),
+			Ref:   [2]int{slot, 0},
+		})
+	}
+	return options
+}
+
+// tradeImplant installs the selected clinic implant or removes the selected installed one
+func (gr *GameRender) tradeImplant(selected ui.MerchantMenuOption) {
+	locManager := engine.GetLocalizationManager()
+	player := gr.gameInstance.Player
+	merchant := gr.gameInstance.Merchant
+	implants := gr.gameInstance.Implants
+	itemName := locManager.Text(selected.Item.Name)
+
+	if gr.merchantMenu.Selling {
+		price, err := merchant.RemoveImplant(player, implants, types.BodyParts(selected.Ref[0]))
+		if err != nil {
+			gr.merchantMenu.Message = tradeErrorText(locManager, err)
+		} else {
+			gr.merchantMenu.Message = locManager.Text("ui.merchant.removed", itemName, price)
+		}
+		return
+	}
+
+	implant, ok := loaders.GetImplant(selected.Item.Key)
+	if !ok {
+		gr.merchantMenu.Message = tradeErrorText(locManager, systems.ErrNotForSale)
+		return
+	}
+	err := merchant.InstallFromShop(player, implants, gr.shop.KeyName, selected.Ref[0], selected.Ref[1], implant, selected.Price)
+	if err != nil {
+		gr.merchantMenu.Message = tradeErrorText(locManager, err)
+	} else {
+		gr.merchantMenu.Message = locManager.Text("ui.merchant.installed", itemName, selected.Price)
+	}
+}
+
+// tradeSelected buys or sells the selected option and reports the outcome in the merchant menu
+func (gr *GameRender) tradeSelected() {
+	if gr.gameInstance == nil || gr.gameInstance.Player == nil || gr.shop == nil {
+		return
+	}
+
+	locManager := engine.GetLocalizationManager()
+	player := gr.gameInstance.Player
+	merchant := gr.gameInstance.Merchant
+	selected := gr.merchantMenu.GetSelected()
+	itemName := locManager.Text(selected.Item.Name)
+
+	if gr.shop.Clinic {
+		if selected.Item.Key != "" {
+			gr.tradeImplant(selected)
+		}
+	} else if gr.merchantMenu.Selling {
+		price, err := merchant.Sell(player, gr.merchantMenu.SelectedIndex())
+		if err != nil {
+			gr.merchantMenu.Message = tradeErrorText(locManager, err)
+		} else {
+			gr.merchantMenu.Message = locManager.Text("ui.merchant.sold", itemName, price)
+		}
+	} else if len(gr.merchantMenu.Options) > 0 {
+		err := merchant.BuyFromShop(player, gr.shop.KeyName, selected.Ref[0], selected.Ref[1], selected.Item, selected.Price)
+		if err != nil {
+			gr.merchantMenu.Message = tradeErrorText(locManager, err)
+		} else {
+			gr.merchantMenu.Message = locManager.Text("ui.merchant.bought", itemName, selected.Price)
+		}
+	}
+
+	gr.refreshMerchant()
+}
+
+// tradeErrorText returns the localized message of a failed trade
+func tradeErrorText(locManager *engine.LocalizationManager, err error) string {
+	switch {
+	case errors.Is(err, systems.ErrNotEnoughCredits):
+		return locManager.Text("ui.merchant.not_enough_credits")
+	case errors.Is(err, systems.ErrInventoryFull):
+		return locManager.Text("ui.merchant.inventory_full")
+	case errors.Is(err, systems.ErrNoImplant):
+		return locManager.Text("ui.merchant.no_implants")
+	case errors.Is(err, systems.ErrOutOfStock):
+		return locManager.Text("ui.merchant.sold_out")
+	default:
+		return locManager.Text("ui.merchant.not_for_sale")
+	}
+}
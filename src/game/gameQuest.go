@@ -0,0 +1,119 @@
+package game
+
+import (
+	"strings"
+
+	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/events"
+	"projectred-rpg.com/game/loaders"
+	"projectred-rpg.com/game/systems"
+	"projectred-rpg.com/game/types"
+	"projectred-rpg.com/ui"
+)
+
+// openQuestLog shows the quest log from exploration
+func (gr *GameRender) openQuestLog() {
+	gr.questLog.Reset()
+	gr.refreshQuestLog()
+	gr.gameState.ChangeState(systems.StateQuestLog)
+}
+
+// closeQuestLog goes back to exploration
+func (gr *GameRender) closeQuestLog() {
+	gr.gameState.ChangeState(systems.StateExploration)
+}
+
+// questCompleted reports whether the quest with key is completed
+func (gr *GameRender) questCompleted(key string) bool {
+	return gr.questSystem.State(key) == types.QuestCompleted
+}
+
+// refreshQuestLog copies the active and completed quests into the quest log
+func (gr *GameRender) refreshQuestLog() {
+	gr.questLog.SetQuests(gr.questLogEntries(types.QuestActive), gr.questLogEntries(types.QuestCompleted))
+}
+
+// questLogEntries returns the quests in a state with the names of their reward items
+func (gr *GameRender) questLogEntries(state types.QuestState) []ui.QuestLogEntry {
+	locManager := engine.GetLocalizationManager()
+	quests, progress := gr.questSystem.Quests(state)
+	entries := make([]ui.QuestLogEntry, len(quests))
+	for i, quest := range quests {
+		entries[i] = ui.QuestLogEntry{
+			Quest:       quest,
+			Progress:    progress[i],
+			RewardItems: itemNames(locManager, rewardItems(quest)),
+		}
+	}
+	return entries
+}
+
+// rewardItems returns the items given by a quest reward
+func rewardItems(quest types.Quest) []types.Item {
+	var items []types.Item
+	for _, entry := range quest.Reward.Items {
+		if item, ok := loaders.LootItem(entry); ok {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// startQuest makes a quest active and tells the player, the items they already carry count right away
+func (gr *GameRender) startQuest(key string) {
+	if !gr.questSystem.Start(key) {
+		return
+	}
+	if quest, ok := loaders.GetQuest(key); ok {
+		locManager := engine.GetLocalizationManager()
+		gr.gameSpace.SetStatus(locManager.Text("ui.quests.started", locManager.Text(quest.Name)))
+	}
+	gr.trackItems()
+}
+
+// onEnemyDefeated counts a downed enemy for the kill objectives
+func (gr *GameRender) onEnemyDefeated(enemy *entities.Enemy) {
+	gr.completeQuests(gr.questSystem.EnemyDefeated(enemy.Archetype))
+}
+
+// trackItems counts the items of the player for the collect objectives, called whenever they acquire items
+func (gr *GameRender) trackItems() {
+	gr.completeQuests(gr.questSystem.ItemsChanged(gr.gameInstance.Player))
+}
+
+// trackRegion counts the region the player stands in for the reach objectives
+func (gr *GameRender) trackRegion() {
+	player := gr.gameInstance.Player
+	if region := gr.currentMap.RegionAt(player.Pos.X, player.Pos.Y); region != nil {
+		gr.completeQuests(gr.questSystem.Reached(region.Name))
+	}
+}
+
+// completeQuests gives the rewards of completed quests, items that do not fit are dropped at the player's feet
+func (gr *GameRender) completeQuests(quests []types.Quest) {
+	if len(quests) == 0 {
+		return
+	}
+
+	locManager := engine.GetLocalizationManager()
+	player := gr.gameInstance.Player
+	var messages []string
+	leveledUp := false
+	for _, quest := range quests {
+		if player.AddExperience(quest.Reward.Exp) > 0 {
+			leveledUp = true
+		}
+		player.AddCredits(quest.Reward.Credits)
+		for _, item := range rewardItems(quest) {
+			gr.giveOrDrop(item)
+		}
+		messages = append(messages, locManager.Text("ui.quests.done", locManager.Text(quest.Name), quest.Reward.Exp, quest.Reward.Credits))
+	}
+	if gr.gameSpace != nil {
+		gr.gameSpace.AddStatus(strings.Join(messages, " · "))
+	}
+	if leveledUp {
+		gr.events.Publish(events.PlayerLeveledUp{Player: player, Level: player.Stats.Level})
+	}
+}
@@ -3,16 +3,20 @@ package game
 import (
 	"fmt"
 	"strings"
-	"time"
 
+	"github.com/charmbracelet/lipgloss"
 	"projectred-rpg.com/config"
 	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/events"
 	"projectred-rpg.com/game/loaders"
 	"projectred-rpg.com/game/systems"
 	"projectred-rpg.com/game/types"
 	"projectred-rpg.com/ui"
 )
 
+// enemyStepTimer is the name of the timer moving enemies in exploration
+const enemyStepTimer = "enemy-steps"
+
 type GameRender struct {
 	// Game Systems
 	gameInstance  *Game
@@ -21,7 +25,14 @@ type GameRender struct {
 	movement      *systems.MovementSystem
 	combatSystem  *systems.CombatSystem
 	spawnerSystem *systems.SpawnerSystem
+	enemyAI       *systems.EnemyAISystem
+	lootSystem    *systems.LootSystem
+	npcSystem     *systems.NPCSystem
+	dialogSystem  *systems.DialogSystem
+	questSystem   *systems.QuestSystem
+	saveSystem    *systems.SaveSystem
 	locManager    *engine.LocalizationManager
+	events        *events.Bus
 
 	// UI Components
 	hud            *ui.HUD
@@ -29,6 +40,9 @@ type GameRender struct {
 	classSelection ui.ClassMenu
 	settingsMenu   ui.SettingsMenu
 	merchantMenu   ui.MerchantMenu
+	inventoryMenu  ui.InventoryMenu
+	questLog       ui.QuestLog
+	saveMenu       ui.Menu
 
 	// Screen/Renderer Settings
 	screenWidth  int
@@ -41,11 +55,23 @@ type GameRender struct {
 	// Add game time
 	// Add lang settings
 	currentMap    *types.TileMap
-	loadedWorldID int // Track currently loaded world
-	loadedStageID int // Track currently loaded stage
+	loadedWorldID int                    // Track currently loaded world
+	loadedStageID int                    // Track currently loaded stage
+	pendingExit   *types.TransitionZone  // Exit the player walked into, nil outside transitions
+	declinedExit  *types.TransitionZone  // Exit the player backed out of, it triggers again once they step off it
+	placeAtSpawn  bool                   // Move the player to the map spawn once the next stage is loaded
+	shop          *types.MerchantCatalog // Catalog of the merchant being visited
+	inventorySort int                    // Position in inventoryOrders of the next sort order
+
+	// Save/Load
+	saveMenuMode    saveMenuMode
+	saveMenuReturn  systems.StateEnum // State to return to when leaving the slot menu
+	pendingDefeated []int             // Spawn IDs to mark defeated once the saved stage is loaded
+
+	enemyStepsOn bool // Whether the timer moving enemies in exploration runs
 }
 
-func initializeGameInstance() *Game {
+func initializeGameInstance(bus *events.Bus) *Game {
 	// Define default player class - could be moved to config
 	defaultClass := types.Class{
 		Name:        "null",
@@ -61,7 +87,7 @@ func initializeGameInstance() *Game {
 	locManager := engine.GetLocalizationManager()
 	currentLang := locManager.GetCurrentLanguage()
 
-	return NewGameInstance(defaultClass, currentLang)
+	return NewGameInstance(defaultClass, currentLang, bus)
 }
 
 func GameModel() *GameRender {
@@ -69,8 +95,10 @@ func GameModel() *GameRender {
 	locManager := engine.GetLocalizationManager()
 	locManager.SetLanguage("fr")
 
+	saveSystem := systems.NewSaveSystem(config.SaveDir, config.SaveSlots)
+
 	// Initialize UI Components
-	menu := InitMainMenu(locManager)
+	menu := InitMainMenu(locManager, saveSystem.HasSaves())
 	hud := ui.NewHud()
 
 	// Init class Select
@@ -85,27 +113,43 @@ func GameModel() *GameRender {
 	}
 	settingsMenu := InitializeSettingsSelection(locManager, supportedLanguages)
 	merchantMenu := InitializeMerchantMenu(locManager)
+	inventoryMenu := InitializeInventoryMenu(locManager)
+	questLog := InitializeQuestLog(locManager)
 
 	// Initialize Game Systems
-	gameInstance := initializeGameInstance()
+	bus := events.NewBus()
+	gameInstance := initializeGameInstance(bus)
 	gameState := systems.NewGameState(systems.StateMainMenu)
 	movement := systems.NewMovementSystem()
 	spawner := systems.NewSpawnerSystem()
-	combatSystem := systems.NewCombatSystem(types.Idle, locManager, spawner)
+	loot := systems.NewLootSystem()
+	combatSystem := systems.NewCombatSystem(types.Idle, locManager, spawner, loot, bus)
+	dialogSystem := systems.NewDialogSystem(60, bus)
+	npcSystem := systems.NewNPCSystem(dialogSystem, bus)
+	questSystem := systems.NewQuestSystem()
 
-	return &GameRender{
+	gr := &GameRender{
 		gameInstance:  gameInstance,
 		gameState:     gameState,
 		movement:      movement,
 		combatSystem:  combatSystem,
 		spawnerSystem: spawner,
+		enemyAI:       systems.NewEnemyAISystem(spawner, movement),
+		lootSystem:    loot,
+		npcSystem:     npcSystem,
+		dialogSystem:  dialogSystem,
+		questSystem:   questSystem,
+		saveSystem:    saveSystem,
 		locManager:    locManager,
+		events:        bus,
 
 		mainMenu:       menu,
 		hud:            hud,
 		settingsMenu:   settingsMenu,
 		classSelection: classSelection,
 		merchantMenu:   merchantMenu,
+		inventoryMenu:  inventoryMenu,
+		questLog:       questLog,
 
 		screenWidth:   80,
 		screenHeight:  24,
@@ -113,6 +157,9 @@ func GameModel() *GameRender {
 		loadedWorldID: -1, // Initialize to invalid values to force first load
 		loadedStageID: -1,
 	}
+	gr.subscribeEvents()
+
+	return gr
 }
 
 func (gr *GameRender) renderGameView() string {
@@ -138,11 +185,30 @@ func (gr *GameRender) renderGameView() string {
 			gr.movement.ResetMap(tm)
 
 			gr.spawnerSystem.LoadStage(gr.gameInstance.CurrentStage)
+			if tm != nil {
+				gr.spawnerSystem.ApplyMarkers(tm.EnemyMarkers)
+			}
+			gr.loadStageNPCs(tm)
+			gr.loadStageLoot(tm, currentWorldID, currentStageID)
+
+			// Restore enemies defeated before the game was saved
+			if gr.pendingDefeated != nil {
+				gr.spawnerSystem.ApplyDefeated(gr.pendingDefeated)
+				gr.pendingDefeated = nil
+			}
 
 			// Update tracking variables
 			gr.loadedWorldID = currentWorldID
 			gr.loadedStageID = currentStageID
 
+			// Place the player on the stage spawn after a new game or a transition
+			if gr.placeAtSpawn && gr.gameInstance.Player != nil {
+				if spawn, ok := gr.stageSpawn(); ok {
+					gr.movement.SetPlayerPositionNoCheck(gr.gameInstance.Player, spawn.X, spawn.Y)
+				}
+				gr.placeAtSpawn = false
+			}
+
 			// Ensure player spawn is valid for the loaded map
 			if gr.gameInstance.Player != nil {
 				gr.movement.EnsureValidSpawn(gr.gameInstance.Player, gr.currentMap)
@@ -155,33 +221,43 @@ func (gr *GameRender) renderGameView() string {
 		activeEnemies := gr.spawnerSystem.GetActiveEnemies()
 		gr.gameSpace.SetEnemies(activeEnemies)
 	}
+	gr.gameSpace.SetLoot(gr.lootSystem.GroundItems(), gr.lootSystem.Chests())
 
 	gameContent := gr.gameSpace.RenderGameWorld(gr.gameInstance.Player)
 
 	return gr.hud.RenderWithContent(gameContent)
 }
 
+// screenTooSmall reports whether the terminal is below the minimum playable size
+func (gr *GameRender) screenTooSmall() bool {
+	return gr.screenWidth < config.MinScreenWidth || gr.screenHeight < config.MinScreenHeight
+}
+
+// renderScreenTooSmall asks the player to enlarge the terminal
+func (gr *GameRender) renderScreenTooSmall() string {
+	locManager := engine.GetLocalizationManager()
+	message := lipgloss.JoinVertical(lipgloss.Center,
+		locManager.Text("ui.screen.too_small", gr.screenWidth, gr.screenHeight),
+		locManager.Text("ui.screen.required", config.MinScreenWidth, config.MinScreenHeight),
+	)
+	return lipgloss.Place(gr.screenWidth, gr.screenHeight, lipgloss.Center, lipgloss.Center, message)
+}
+
 // renderStageTransition renders the stage transition screen
 func (gr *GameRender) renderStageTransition() string {
 	var message string
 
 	if gr.gameInstance != nil && gr.gameInstance.CurrentWorld != nil && gr.gameInstance.CurrentStage != nil {
-		currentStageNb := gr.gameInstance.CurrentStage.StageNb
-		nextStageNb := currentStageNb + 1
-
-		// Check if there's a next stage in current world
-		stageExists := false
-		for _, stage := range gr.gameInstance.CurrentWorld.Stages {
-			if stage.StageNb == nextStageNb {
-				stageExists = true
-				break
-			}
-		}
+		worldID, _, ok := gr.nextDestination()
 
-		if stageExists {
+		switch {
+		case !ok:
+			message = "🏁 End of the Road 🏁\n\n"
+			message += "There is nowhere further to go, for now...\n\n"
+		case worldID == gr.gameInstance.CurrentWorld.WorldID:
 			message = "🎉 Stage Cleared! 🎉\n\n"
 			message += "Proceeding to next stage...\n\n"
-		} else {
+		default:
 			message = "🌟 World Completed! 🌟\n\n"
 			message += "Advancing to next world...\n\n"
 		}
@@ -189,6 +265,10 @@ func (gr *GameRender) renderStageTransition() string {
 		message = "🎉 Area Cleared! 🎉\n\n"
 	}
 
+	if reward := gr.clearingReward(); reward > 0 {
+		message += engine.GetLocalizationManager().Text("ui.hud.reward", reward) + "\n\n"
+	}
+
 	message += "Press SPACE or ENTER to continue\n"
 	message += "Press Q to quit"
 
@@ -218,7 +298,11 @@ func (gr *GameRender) Update(msg engine.Msg) (engine.Model, engine.Cmd) {
 	case engine.SizeMsg:
 		gr.handleSizeUpdate(msg)
 	case engine.KeyMsg:
-		return gr.handleKeyInput(msg)
+		model, cmd := gr.handleKeyInput(msg)
+		if cmd == nil {
+			cmd = gr.startEnemySteps()
+		}
+		return model, cmd
 	case engine.TickMsg:
 		// Handle level intro tick updates
 		if gr.gameInstance != nil && gr.gameInstance.IsShowingIntro() {
@@ -226,9 +310,14 @@ func (gr *GameRender) Update(msg engine.Msg) (engine.Model, engine.Cmd) {
 			gr.gameInstance.LevelIntro, cmd = gr.gameInstance.LevelIntro.Update(msg)
 			return gr, cmd
 		}
-		// Keep ticking during combat to process enemy turns
-		if gr.gameState.CurrentState == systems.StateCombat {
-			return gr, engine.Tick(time.Second / 60) // 60 FPS tick rate
+	case engine.FrameMsg:
+		return gr.handleFrame(msg)
+	case engine.TimerMsg:
+		switch msg.Name {
+		case enemyStepTimer:
+			return gr.handleEnemyStep()
+		case dialogueTypeTimer:
+			return gr.handleDialogueTyping(msg)
 		}
 	default:
 		// Handle other message types
@@ -236,6 +325,46 @@ func (gr *GameRender) Update(msg engine.Msg) (engine.Model, engine.Cmd) {
 	return gr, nil
 }
 
+// handleFrame advances combat by the frame delta and stops frames once combat is over
+func (gr *GameRender) handleFrame(msg engine.FrameMsg) (engine.Model, engine.Cmd) {
+	if gr.gameState.CurrentState != systems.StateCombat {
+		return gr, engine.StopFrames()
+	}
+
+	if gr.combatSystem != nil && gr.gameInstance != nil && gr.gameInstance.Player != nil {
+		gr.combatSystem.Update(gr.gameInstance.Player, msg.Delta)
+	}
+
+	// Apply the end of combat right away rather than on the next message
+	gr.updateGameSystems()
+	if gr.gameState.CurrentState != systems.StateCombat {
+		return gr, engine.StopFrames()
+	}
+	return gr, nil
+}
+
+// startEnemySteps starts the timer moving enemies once the game reaches exploration, nil when it already runs
+func (gr *GameRender) startEnemySteps() engine.Cmd {
+	if gr.enemyStepsOn || gr.gameState.CurrentState != systems.StateExploration {
+		return nil
+	}
+	gr.enemyStepsOn = true
+	return engine.Every(enemyStepTimer, config.EnemyStepInterval)
+}
+
+// handleEnemyStep moves the enemies of the stage and starts a fight when one reaches the player
+func (gr *GameRender) handleEnemyStep() (engine.Model, engine.Cmd) {
+	if gr.gameState.CurrentState != systems.StateExploration || gr.gameInstance == nil || gr.gameInstance.IsShowingIntro() {
+		return gr, nil
+	}
+
+	player := gr.gameInstance.Player
+	if gr.enemyAI.Step(player, gr.currentMap) && gr.combatSystem.TryEngageCombat(player) {
+		return gr, gr.enterCombat()
+	}
+	return gr, nil
+}
+
 func (gr *GameRender) handleKeyInput(msg engine.KeyMsg) (engine.Model, engine.Cmd) {
 	currentState := gr.gameState.CurrentState
 
@@ -254,6 +383,12 @@ func (gr *GameRender) handleKeyInput(msg engine.KeyMsg) (engine.Model, engine.Cm
 		return gr.handleSettingsSelectionInput(msg)
 	case systems.StateMerchant:
 		return gr.handleMerchantInput(msg)
+	case systems.StateInventory:
+		return gr.handleInventoryInput(msg)
+	case systems.StateQuestLog:
+		return gr.handleQuestLogInput(msg)
+	case systems.StateDialogue:
+		return gr.handleDialogueInput(msg)
 	case systems.StateExploration:
 		return gr.handleGameInput(msg)
 	case systems.StateCombat:
@@ -262,6 +397,8 @@ func (gr *GameRender) handleKeyInput(msg engine.KeyMsg) (engine.Model, engine.Cm
 		return gr.handleDebugInput(msg)
 	case systems.StateStageTransition:
 		return gr.handleStageTransitionInput(msg)
+	case systems.StateSaveSlots:
+		return gr.handleSaveSlotInput(msg)
 
 	default:
 		return gr, nil
@@ -298,14 +435,16 @@ func (gr *GameRender) handleLevelIntroInput(msg engine.KeyMsg) (engine.Model, en
 func (gr *GameRender) handleStageTransitionInput(msg engine.KeyMsg) (engine.Model, engine.Cmd) {
 	switch msg.Rune {
 	case ' ', '\r', '\n': // Space, Enter to proceed
-		gr.transitionToNextLevel()
+		if !gr.transitionToNextLevel() {
+			// Nowhere to go: stay on the stage, the exit waits for the player to step off it
+			gr.declineExit()
+		}
 		gr.gameState.ChangeState(systems.StateExploration)
-		spawn := gr.gameInstance.CurrentStage.PlayerSpawn
-		gr.gameInstance.Movement.SetPlayerPosition(gr.GetPlayer(), spawn.X, spawn.Y, gr.gameSpace.tileMap)
 		return gr, nil
 	case 'q', 'Q': // Allow quitting
 		return gr, func() engine.Msg { return engine.Quit() }
 	case 27: // ESC - go back to exploration
+		gr.declineExit()
 		gr.gameState.ChangeState(systems.StateExploration)
 		return gr, nil
 	}
@@ -332,6 +471,10 @@ func (gr *GameRender) View() string {
 		return "Error: Game state is nil"
 	}
 
+	if gr.screenTooSmall() {
+		return gr.renderScreenTooSmall()
+	}
+
 	// If showing level intro, render it over everything
 	if gr.gameInstance != nil && gr.gameInstance.IsShowingIntro() {
 		return gr.gameInstance.LevelIntro.Render()
@@ -355,13 +498,31 @@ func (gr *GameRender) View() string {
 		return "Combat UI not initialized"
 	case systems.StateMerchant:
 		return gr.merchantMenu.View()
+	case systems.StateInventory:
+		return gr.inventoryMenu.View()
+	case systems.StateQuestLog:
+		return gr.questLog.View()
+	case systems.StateDialogue:
+		return gr.renderDialogueView()
 	case systems.StateStageTransition:
 		return gr.renderStageTransition()
+	case systems.StateSaveSlots:
+		return gr.saveMenu.View()
 	case systems.StateDebugMenu:
 		x, y := gr.gameInstance.Player.GetPosition()
-		x1, y1 := gr.gameInstance.CurrentStage.PlayerSpawn.X, gr.gameInstance.CurrentStage.PlayerSpawn.Y
-
-		return fmt.Sprintf("Player Position: %d %d // Player Spawn: %d %d", x, y, x1, y1)
+		spawn, _ := gr.stageSpawn()
+		x1, y1 := spawn.X, spawn.Y
+
+		debug := fmt.Sprintf("Player Position: %d %d // Player Spawn: %d %d", x, y, x1, y1)
+		if renderer := engine.GetGlobalRenderer(); renderer != nil {
+			stats := renderer.Stats()
+			debug += fmt.Sprintf("\nFrames: %d // Last Frame: %d bytes in %s // Total: %d bytes, %s per frame",
+				stats.Frames, stats.LastFrameBytes, stats.LastFrameTime, stats.BytesWritten, stats.AverageFrameTime())
+		}
+		for _, warning := range loaders.Warnings() {
+			debug += "\n" + warning
+		}
+		return debug
 	default:
 		return "Unknown State"
 	}
@@ -370,23 +531,6 @@ func (gr *GameRender) View() string {
 // SetRenderer initializes the combat UI with the provided renderer
 func (gr *GameRender) SetRenderer(renderer engine.Renderer) {
 	gr.combatSystem.SetRenderer(renderer)
-
-	// Set up combat exit callback to refresh gameSpace
-	gr.combatSystem.SetExitCallback(func() {
-		if gr.gameSpace != nil {
-			// Clean up defeated enemies first
-			gr.gameSpace.RemoveDeadEnemies()
-
-			// Always refresh with the latest spawner data
-			if gr.spawnerSystem != nil {
-				// Clean up any defeated enemies in the spawner system
-				gr.spawnerSystem.RemoveDefeatedEnemies()
-				activeEnemies := gr.spawnerSystem.GetActiveEnemies()
-				gr.gameSpace.ForceRefreshEnemies(activeEnemies)
-			}
-		}
-	})
-
 }
 
 // forceStageReload resets stage tracking to force a reload on next render
@@ -395,39 +539,106 @@ func (gr *GameRender) forceStageReload() {
 	gr.loadedStageID = -1
 }
 
-// À ajouter dans votre fichier GameRender principal
-func (gr *GameRender) transitionToNextLevel() {
+// nextDestination returns the world and stage reached through the pending exit.
+// Without one, as with the debug key, the map's first exit with its quest done is taken, none when every exit is locked.
+// Exits without a target lead to the next stage, or to the first stage of the next world.
+func (gr *GameRender) nextDestination() (worldID, stageNb int, ok bool) {
 	if gr.gameInstance == nil || gr.gameInstance.CurrentWorld == nil || gr.gameInstance.CurrentStage == nil {
-		return
+		return 0, 0, false
 	}
 
 	currentWorldID := gr.gameInstance.CurrentWorld.WorldID
 	currentStageNb := gr.gameInstance.CurrentStage.StageNb
 
-	nextStageNb := currentStageNb + 1
+	exit := gr.pendingExit
+	if exit == nil {
+		if gr.exitsLocked() {
+			return 0, 0, false
+		}
+		exit = gr.currentMap.UnlockedExit(gr.questCompleted)
+	}
 
-	stageExists := false
-	for _, stage := range gr.gameInstance.CurrentWorld.Stages {
-		if stage.StageNb == nextStageNb {
-			stageExists = true
-			break
+	if exit != nil && exit.HasTarget() {
+		worldID, stageNb = exit.TargetWorld, exit.TargetStage
+		if worldID == 0 {
+			worldID = currentWorldID
+		}
+		if stageNb == 0 {
+			stageNb = 1
 		}
+		world, exists := loaders.GetWorld(worldID)
+		if !exists || world.GetStage(stageNb) == nil {
+			return 0, 0, false
+		}
+		return worldID, stageNb, true
 	}
 
-	if stageExists {
-		gr.gameInstance.LoadStage(currentWorldID, nextStageNb)
-		gr.forceStageReload() // Reset tracking for new stage
-	} else {
-		nextWorldID := currentWorldID + 1
+	if gr.gameInstance.CurrentWorld.GetStage(currentStageNb+1) != nil {
+		return currentWorldID, currentStageNb + 1, true
+	}
 
-		if world, exists := loaders.GetWorld(nextWorldID); exists && len(world.Stages) > 0 {
-			gr.gameInstance.LoadStage(nextWorldID, 1)
-			gr.forceStageReload() // Reset tracking for new stage
-		} else {
-			return
-		}
+	if world, exists := loaders.GetWorld(currentWorldID + 1); exists && len(world.Stages) > 0 {
+		return currentWorldID + 1, 1, true
+	}
+	return 0, 0, false
+}
+
+// exitsLocked reports whether the map has exits and quests still lock every one of them
+func (gr *GameRender) exitsLocked() bool {
+	return gr.currentMap != nil && len(gr.currentMap.TransitionZones) > 0 && gr.currentMap.UnlockedExit(gr.questCompleted) == nil
+}
+
+// clearingReward returns the credits earned by leaving the cleared stage through the pending exit.
+// The world reward is added when the exit leads to another world.
+func (gr *GameRender) clearingReward() int {
+	worldID, _, ok := gr.nextDestination()
+	if !ok || gr.spawnerSystem == nil || !gr.spawnerSystem.IsStageCleared() {
+		return 0
 	}
 
+	reward := gr.gameInstance.CurrentStage.ClearingReward
+	if worldID != gr.gameInstance.CurrentWorld.WorldID {
+		reward += gr.gameInstance.CurrentWorld.ClearingReward
+	}
+	return reward
+}
+
+// declineExit leaves the pending exit without taking it, it does not trigger again until the player steps off it
+func (gr *GameRender) declineExit() {
+	gr.declinedExit = gr.pendingExit
+	gr.pendingExit = nil
+}
+
+// transitionToNextLevel credits the clearing reward and loads the destination of the pending exit,
+// reporting false when the exit leads nowhere
+func (gr *GameRender) transitionToNextLevel() bool {
+	reward := gr.clearingReward()
+	worldID, stageNb, ok := gr.nextDestination()
+	if !ok {
+		return false
+	}
+	gr.pendingExit = nil
+	gr.declinedExit = nil
+	gr.gameInstance.Player.AddCredits(reward)
+
+	// Drop the old map so its exits stop triggering until the new stage is loaded
+	gr.currentMap = nil
+	gr.placeAtSpawn = true
+
+	gr.gameInstance.LoadStage(worldID, stageNb)
+	return true
+}
+
+// stageSpawn returns the player spawn of the current stage, preferring the one declared by the map
+func (gr *GameRender) stageSpawn() (types.Position, bool) {
+	if gr.currentMap != nil && gr.currentMap.PlayerSpawn != nil {
+		return *gr.currentMap.PlayerSpawn, true
+	}
+	if gr.gameInstance != nil && gr.gameInstance.CurrentStage != nil &&
+		gr.gameInstance.CurrentStage.PlayerSpawn != (types.Position{}) {
+		return gr.gameInstance.CurrentStage.PlayerSpawn, true
+	}
+	return types.Position{}, false
 }
 
 func (gr *GameRender) setPlayerPosition(x, y int) bool {
@@ -0,0 +1,45 @@
+package game
+
+import (
+	"testing"
+
+	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/loaders"
+	"projectred-rpg.com/game/systems"
+)
+
+// TestLastExitDoesNotTrapThePlayer checks the exit of the last stage can be backed out of and triggers again once left
+func TestLastExitDoesNotTrapThePlayer(t *testing.T) {
+	t.Chdir("..")
+
+	gr := GameModel()
+	world := NewWorld(2)
+	gr.gameInstance.CurrentWorld = world
+	gr.gameInstance.CurrentStage = world.GetStage(2)
+	gr.currentMap = loaders.LoadStageMap(2, 2)
+	gr.gameState.ChangeState(systems.StateExploration)
+
+	player := gr.gameInstance.Player
+	player.Pos.X, player.Pos.Y = 9, 7
+	gr.updateGameSystems()
+	if gr.gameState.CurrentState != systems.StateStageTransition {
+		t.Fatalf("state is %v on the exit of a cleared stage, want the stage transition", gr.gameState.CurrentState)
+	}
+
+	for _, key := range []rune{'\r', engine.RuneEscape} {
+		gr.handleStageTransitionInput(engine.KeyFromRune(key))
+		gr.updateGameSystems()
+		if gr.gameState.CurrentState != systems.StateExploration {
+			t.Fatalf("state is %v after %q on an exit leading nowhere, want exploration", gr.gameState.CurrentState, key)
+		}
+
+		// Stepping off and back on the exit shows the transition again
+		player.Pos.X = 20
+		gr.updateGameSystems()
+		player.Pos.X = 9
+		gr.updateGameSystems()
+		if gr.gameState.CurrentState != systems.StateStageTransition {
+			t.Fatalf("state is %v back on the exit after %q, want the stage transition", gr.gameState.CurrentState, key)
+		}
+	}
+}
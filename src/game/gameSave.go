@@ -0,0 +1,132 @@
+package game
+
+import (
+	"errors"
+	"strconv"
+
+	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/systems"
+)
+
+// saveMenuMode tells the slot menu whether picking a slot saves or loads
+type saveMenuMode int
+
+const (
+	saveModeSave saveMenuMode = iota
+	saveModeLoad
+)
+
+// snapshotSave captures the current run into a SaveData
+func (gr *GameRender) snapshotSave() (*systems.SaveData, error) {
+	if gr.gameInstance == nil || gr.gameInstance.Player == nil ||
+		gr.gameInstance.CurrentWorld == nil || gr.gameInstance.CurrentStage == nil {
+		return nil, errors.New("no game in progress")
+	}
+
+	data := &systems.SaveData{
+		Player:  *gr.gameInstance.Player,
+		WorldID: gr.gameInstance.CurrentWorld.WorldID,
+		StageNb: gr.gameInstance.CurrentStage.StageNb,
+	}
+
+	// Only record defeated enemies if the spawner holds the current stage
+	if gr.loadedWorldID == data.WorldID && gr.loadedStageID == data.StageNb && gr.spawnerSystem != nil {
+		data.DefeatedEnemies = gr.spawnerSystem.DefeatedSpawnIDs()
+	}
+	data.OpenedChests = gr.lootSystem.OpenedChests()
+	data.DialogueFlags = gr.dialogSystem.Flags()
+	data.Quests = gr.questSystem.Progress()
+
+	return data, nil
+}
+
+// saveGame writes the current run to a slot
+func (gr *GameRender) saveGame(slot int) error {
+	data, err := gr.snapshotSave()
+	if err != nil {
+		return err
+	}
+	return gr.saveSystem.Save(slot, data)
+}
+
+// loadGame restores a run from a slot and switches to exploration
+func (gr *GameRender) loadGame(slot int) error {
+	data, err := gr.saveSystem.Load(slot)
+	if err != nil {
+		return err
+	}
+
+	currentLang := engine.GetLocalizationManager().GetCurrentLanguage()
+	gr.gameInstance = NewGameFromSave(data, currentLang, gr.events)
+	gr.pendingDefeated = data.DefeatedEnemies
+	gr.lootSystem.Reset(data.OpenedChests)
+	gr.dialogSystem.RestoreFlags(data.DialogueFlags)
+	gr.questSystem.Reset(data.Quests)
+	gr.gameInstance.ResumeStage()
+
+	gr.gameState.ChangeState(systems.StateExploration)
+	return nil
+}
+
+// openSaveMenu shows the slot picker in save or load mode
+func (gr *GameRender) openSaveMenu(mode saveMenuMode) {
+	locManager := engine.GetLocalizationManager()
+	title := locManager.Text("ui.save.save_title")
+	if mode == saveModeLoad {
+		title = locManager.Text("ui.save.load_title")
+	}
+
+	gr.saveMenuMode = mode
+	gr.saveMenuReturn = gr.gameState.CurrentState
+	gr.saveMenu = InitSaveSlotMenu(locManager, title, gr.saveSystem.ListSlots())
+	gr.saveMenu, _ = gr.saveMenu.Update(engine.SizeMsg{Width: gr.screenWidth, Height: gr.screenHeight})
+	gr.gameState.ChangeState(systems.StateSaveSlots)
+}
+
+// refreshMainMenu rebuilds the main menu so the Continue entry follows the save slots
+func (gr *GameRender) refreshMainMenu() {
+	gr.mainMenu = InitMainMenu(engine.GetLocalizationManager(), gr.saveSystem.HasSaves())
+	gr.mainMenu, _ = gr.mainMenu.Update(engine.SizeMsg{Width: gr.screenWidth, Height: gr.screenHeight})
+}
+
+// handleSaveSlotInput handles input in the save/load slot picker
+func (gr *GameRender) handleSaveSlotInput(msg engine.KeyMsg) (engine.Model, engine.Cmd) {
+	switch msg.Rune {
+	case '\r', '\n', ' ':
+		slot, err := strconv.Atoi(gr.saveMenu.GetSelected().Value)
+		if err != nil {
+			return gr, nil
+		}
+
+		locManager := engine.GetLocalizationManager()
+		if gr.saveMenuMode == saveModeSave {
+			if err := gr.saveGame(slot); err != nil {
+				gr.saveMenu.Title = locManager.Text("ui.save.error", err)
+				return gr, nil
+			}
+			gr.refreshMainMenu()
+			gr.gameState.ChangeState(gr.saveMenuReturn)
+			return gr, nil
+		}
+
+		if err := gr.loadGame(slot); err != nil {
+			if errors.Is(err, systems.ErrSaveSlotEmpty) {
+				gr.saveMenu.Title = locManager.Text("ui.save.empty", slot)
+			} else {
+				gr.saveMenu.Title = locManager.Text("ui.save.error", err)
+			}
+		}
+		return gr, nil
+
+	case 'q', 27:
+		gr.gameState.ChangeState(gr.saveMenuReturn)
+		if gr.saveMenuReturn == systems.StateMainMenu {
+			gr.refreshMainMenu()
+		}
+		return gr, nil
+
+	default:
+		gr.saveMenu, _ = gr.saveMenu.Update(msg)
+	}
+	return gr, nil
+}
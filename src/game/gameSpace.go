@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"projectred-rpg.com/config"
+	"projectred-rpg.com/engine"
 	"projectred-rpg.com/game/entities"
 	"projectred-rpg.com/game/types"
 )
@@ -14,6 +15,9 @@ type GameRenderer struct {
 	height  int
 	tileMap *types.TileMap
 	enemies []*entities.Enemy
+	npcs    []*types.NPC
+	items   []types.GroundItem
+	chests  []types.Chest
 	viewX   int // top-left map X of the viewport
 	viewY   int // top-left map Y of the viewport
 	// inner viewport rectangle (borders will be drawn around this)
@@ -21,8 +25,31 @@ type GameRenderer struct {
 	innerY int // top-left Y of viewport border in screen grid
 	innerW int // viewport interior width (in cells)
 	innerH int // viewport interior height (in cells)
+
+	status string // Message shown on the bottom border of the viewport
 }
 
+// Glyphs of the loot lying on the map
+const (
+	groundItemGlyph   = '✦'
+	chestSprite       = "[■]"
+	openedChestSprite = "[ ]"
+)
+
+// Styles of the exploration view layers
+var (
+	borderStyle = engine.CellStyle{Fg: "#5f6f7f"}
+	statusStyle = engine.CellStyle{Fg: "#ffffff", Bold: true}
+	exitStyle   = engine.CellStyle{Fg: "#ffd700", Bold: true}
+	enemyStyle  = engine.CellStyle{Fg: "#ff5f5f", Bold: true}
+	npcStyle    = engine.CellStyle{Fg: "#c792ea", Bold: true}
+	alertStyle  = engine.CellStyle{Fg: "#ffd700", Bold: true}
+	playerStyle = engine.CellStyle{Fg: "#00e5ff", Bold: true}
+	lootStyle   = engine.CellStyle{Fg: "#7fff7f", Bold: true}
+	chestStyle  = engine.CellStyle{Fg: "#d2a05a", Bold: true}
+	openedStyle = engine.CellStyle{Fg: "#8a7a6a", Dim: true}
+)
+
 func NewGameRenderer(width, height int) *GameRenderer {
 	return &GameRenderer{
 		width:  width,
@@ -38,43 +65,24 @@ func (gr *GameRenderer) RenderGameWorld(player *types.Player) string {
 	// Compute inner viewport layout and adjust camera
 	gr.computeLayout(player)
 
-	// Initialize draw state and grid
-	grid := gr.initializeGrid()
+	// Initialize the cell buffer, every cell starts blank
+	buf := engine.NewCellBuffer(gr.width, gr.height)
 
 	// Render in organized layers
-	gr.renderBackground(grid)
-	gr.renderMap(grid)
-	gr.renderBorders(grid)
-	gr.renderEnemies(grid)
-	gr.renderPlayer(grid, player)
-
-	// Convert grid to string efficiently
-	return gr.gridToString(grid)
-}
-
-// initializeGrid creates the base grid for rendering
-func (gr *GameRenderer) initializeGrid() [][]rune {
-	grid := make([][]rune, gr.height)
-	for i := range grid {
-		grid[i] = make([]rune, gr.width)
-		for j := range grid[i] {
-			grid[i][j] = ' '
-		}
-	}
-	return grid
-}
-
-// renderBackground creates background patterns and terrain
-func (gr *GameRenderer) renderBackground(grid [][]rune) {
-	for i := 1; i < gr.height-1; i++ {
-		for j := 1; j < gr.width-1; j++ {
-			grid[i][j] = ' '
-		}
-	}
+	gr.renderMap(buf)
+	gr.renderBorders(buf)
+	gr.renderStatus(buf)
+	gr.renderLoot(buf)
+	gr.renderNPCs(buf)
+	gr.renderEnemies(buf)
+	gr.renderPlayer(buf, player)
+
+	// Convert cells to styled text
+	return buf.Render()
 }
 
 // renderBorders draws the game area borders
-func (gr *GameRenderer) renderBorders(grid [][]rune) {
+func (gr *GameRenderer) renderBorders(buf *engine.CellBuffer) {
 	if gr.innerW <= 0 || gr.innerH <= 0 {
 		return
 	}
@@ -88,21 +96,21 @@ func (gr *GameRenderer) renderBorders(grid [][]rune) {
 		if top >= 0 && top < gr.height {
 			switch {
 			case x == left:
-				grid[top][x] = '┌'
+				buf.Set(x, top, '┌', borderStyle)
 			case x == right:
-				grid[top][x] = '┐'
+				buf.Set(x, top, '┐', borderStyle)
 			default:
-				grid[top][x] = '─'
+				buf.Set(x, top, '─', borderStyle)
 			}
 		}
 		if bottom >= 0 && bottom < gr.height {
 			switch {
 			case x == left:
-				grid[bottom][x] = '└'
+				buf.Set(x, bottom, '└', borderStyle)
 			case x == right:
-				grid[bottom][x] = '┘'
+				buf.Set(x, bottom, '┘', borderStyle)
 			default:
-				grid[bottom][x] = '─'
+				buf.Set(x, bottom, '─', borderStyle)
 			}
 		}
 	}
@@ -110,10 +118,10 @@ func (gr *GameRenderer) renderBorders(grid [][]rune) {
 	for y := top + 1; y < bottom; y++ {
 		if y >= 0 && y < gr.height {
 			if left >= 0 && left < gr.width {
-				grid[y][left] = '│'
+				buf.Set(left, y, '│', borderStyle)
 			}
 			if right >= 0 && right < gr.width {
-				grid[y][right] = '│'
+				buf.Set(right, y, '│', borderStyle)
 			}
 		}
 	}
@@ -303,9 +311,9 @@ func (gr *GameRenderer) computeLayout(player *types.Player) {
 	gr.updateViewport(player)
 }
 
-// renderMap draws the tile map into the grid, clipped within borders
+// renderMap draws the tile map into the buffer, clipped within borders
 // Skips rendering outer wall characters (first/last row/column) but keeps them in map data
-func (gr *GameRenderer) renderMap(grid [][]rune) {
+func (gr *GameRenderer) renderMap(buf *engine.CellBuffer) {
 	if gr.tileMap == nil {
 		return
 	}
@@ -314,33 +322,92 @@ func (gr *GameRenderer) renderMap(grid [][]rune) {
 		mapY := gr.viewY + y
 		for x := 0; x < gr.innerW; x++ {
 			mapX := gr.viewX + x
-			ch := gr.tileMap.At(mapX, mapY)
+			ch, props, _ := gr.tileMap.TileAt(mapX, mapY)
 			if ch == 0 {
 				ch = ' '
 			}
+			style := engine.CellStyle{
+				Fg:   props.Foreground,
+				Bg:   props.Background,
+				Bold: props.Bold,
+				Dim:  props.Dim,
+			}
 
 			// Check if this position is in an active transition zone
-			if gr.tileMap.TransitionZone != nil && gr.tileMap.TransitionZone.Active &&
-				gr.tileMap.TransitionZone.IsInZone(mapX, mapY) {
+			if gr.tileMap.IsInTransitionZone(mapX, mapY) {
 				ch = '◊' // Special character for transition zone
+				style = exitStyle
 			}
 
 			// Skip rendering outer walls (first/last row/column of the map)
 			// but keep them in the map data for collision detection
 			if gr.isOuterWall(mapX, mapY) {
 				ch = ' ' // Render as empty space instead
+				style = engine.CellStyle{}
 			}
 
-			sy := gr.innerY + 1 + y
-			sx := gr.innerX + 1 + x
-			if sy >= 0 && sy < gr.height && sx >= 0 && sx < gr.width {
-				grid[sy][sx] = ch
+			buf.Set(gr.innerX+1+x, gr.innerY+1+y, ch, style)
+		}
+	}
+}
+
+// SetLoot sets the items on the ground and the chests to draw
+func (gr *GameRenderer) SetLoot(items []types.GroundItem, chests []types.Chest) {
+	gr.items = items
+	gr.chests = chests
+}
+
+// renderLoot draws the chests and the items lying on the ground
+func (gr *GameRenderer) renderLoot(buf *engine.CellBuffer) {
+	for _, chest := range gr.chests {
+		sprite, style := chestSprite, chestStyle
+		if chest.Opened {
+			sprite, style = openedChestSprite, openedStyle
+		}
+		for j, ch := range []rune(sprite) {
+			gr.setMapCell(buf, chest.Pos.X+j, chest.Pos.Y, ch, style)
+		}
+	}
+	for _, item := range gr.items {
+		gr.setMapCell(buf, item.Pos.X, item.Pos.Y, groundItemGlyph, lootStyle)
+	}
+}
+
+// SetNPCs sets the NPCs standing on the stage
+func (gr *GameRenderer) SetNPCs(npcs []*types.NPC) {
+	gr.npcs = npcs
+}
+
+// renderNPCs draws the sprites of the NPCs, blank sprite cells leave the map visible
+func (gr *GameRenderer) renderNPCs(buf *engine.CellBuffer) {
+	for _, npc := range gr.npcs {
+		for i, line := range strings.Split(npc.GetSprite(), "\n") {
+			for j, ch := range []rune(line) {
+				if ch != ' ' {
+					gr.setMapCell(buf, npc.Pos.X+j, npc.Pos.Y+i, ch, npcStyle)
+				}
 			}
 		}
 	}
 }
 
-func (gr *GameRenderer) renderEnemies(grid [][]rune) {
+// screenPosition returns where the 1-based map tile is drawn in the game view, false when it is outside the viewport
+func (gr *GameRenderer) screenPosition(pos types.Position) (int, int, bool) {
+	x, y := pos.X-1-gr.viewX, pos.Y-1-gr.viewY
+	if x < 0 || x >= gr.innerW || y < 0 || y >= gr.innerH {
+		return 0, 0, false
+	}
+	return gr.innerX + 1 + x, gr.innerY + 1 + y, true
+}
+
+// setMapCell draws a cell at 1-based map coordinates when it is inside the viewport
+func (gr *GameRenderer) setMapCell(buf *engine.CellBuffer, mapX, mapY int, ch rune, style engine.CellStyle) {
+	if x, y, ok := gr.screenPosition(types.Position{X: mapX, Y: mapY}); ok {
+		buf.Set(x, y, ch, style)
+	}
+}
+
+func (gr *GameRenderer) renderEnemies(buf *engine.CellBuffer) {
 	if gr.enemies == nil {
 		return
 	}
@@ -368,16 +435,20 @@ func (gr *GameRenderer) renderEnemies(grid [][]rune) {
 				enemySprite = defaultEnemySprite
 			}
 
+			// A chasing enemy shows an exclamation mark over its head
+			alertY := gr.innerY + (enemyY - gr.viewY - 1)
+			if enemy.Alert == types.AlertChase && alertY > gr.innerY {
+				buf.Set(gr.innerX+1+(enemyX-gr.viewX-1)+1, alertY, '!', alertStyle)
+			}
+
 			// Render enemy sprite similar to player rendering
 			spriteLines := strings.Split(enemySprite, "\n")
 			for i, line := range spriteLines {
 				y := gr.innerY + 1 + (enemyY - gr.viewY - 1) + i
-				if y >= 0 && y < gr.height {
-					for j, char := range line {
-						x := gr.innerX + 1 + (enemyX - gr.viewX - 1) + j
-						if x >= 0 && x < gr.width && char != ' ' {
-							grid[y][x] = char
-						}
+				for j, char := range []rune(line) {
+					x := gr.innerX + 1 + (enemyX - gr.viewX - 1) + j
+					if char != ' ' {
+						buf.Set(x, y, char, enemyStyle)
 					}
 				}
 			}
@@ -394,8 +465,8 @@ func (gr *GameRenderer) isOuterWall(mapX, mapY int) bool {
 	return config.IsOuterWall(mapX, mapY, gr.tileMap.Width, gr.tileMap.Height)
 }
 
-// renderPlayer draws the player sprite on the grid using viewport offset
-func (gr *GameRenderer) renderPlayer(grid [][]rune, player *types.Player) {
+// renderPlayer draws the player sprite on the buffer using viewport offset
+func (gr *GameRenderer) renderPlayer(buf *engine.CellBuffer, player *types.Player) {
 	if player == nil {
 		return
 	}
@@ -403,26 +474,46 @@ func (gr *GameRenderer) renderPlayer(grid [][]rune, player *types.Player) {
 	spriteLines := strings.Split(player.GetSprite(), "\n")
 	for i, line := range spriteLines {
 		y := gr.innerY + 1 + (playerY - gr.viewY - 1) + i
-		if y >= 0 && y < gr.height {
-			for j, char := range line {
-				x := gr.innerX + 1 + (playerX - gr.viewX - 1) + j
-				if x >= 0 && x < gr.width {
-					grid[y][x] = char
-				}
-			}
+		for j, char := range []rune(line) {
+			x := gr.innerX + 1 + (playerX - gr.viewX - 1) + j
+			buf.Set(x, y, char, playerStyle)
 		}
 	}
 }
 
-// gridToString converts the grid to a string efficiently
-func (gr *GameRenderer) gridToString(grid [][]rune) string {
-	var builder strings.Builder
-	builder.Grow(gr.width * gr.height)
-	for _, row := range grid {
-		builder.WriteString(string(row))
-		builder.WriteString("\n")
+// SetStatus sets the message shown on the bottom border of the viewport, "" hides it
+func (gr *GameRenderer) SetStatus(status string) {
+	gr.status = status
+}
+
+// Status returns the message shown on the bottom border
+func (gr *GameRenderer) Status() string {
+	return gr.status
+}
+
+// AddStatus appends a message to the one shown on the bottom border
+func (gr *GameRenderer) AddStatus(status string) {
+	if gr.status == "" {
+		gr.status = status
+		return
+	}
+	gr.status += " · " + status
+}
+
+// renderStatus writes the status message over the bottom border
+func (gr *GameRenderer) renderStatus(buf *engine.CellBuffer) {
+	bottom := gr.innerY + gr.innerH + 1
+	if gr.status == "" || bottom < 0 || bottom >= gr.height {
+		return
+	}
+	text := []rune(" " + gr.status + " ")
+	for i, ch := range text {
+		x := gr.innerX + 2 + i
+		if x >= gr.innerX+gr.innerW || x >= gr.width {
+			break
+		}
+		buf.Set(x, bottom, ch, statusStyle)
 	}
-	return strings.TrimRight(builder.String(), "\n")
 }
 
 // Extension points for future systems can be added here when needed.
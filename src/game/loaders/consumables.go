@@ -0,0 +1,88 @@
+package loaders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/game/types"
+)
+
+var (
+	consumableCache   map[string]types.ConsumableData
+	consumableMutex   sync.RWMutex
+	consumablesLoaded bool = false
+)
+
+// LoadConsumables loads all consumables from JSON files in assets/data/consumables directory
+func LoadConsumables() error {
+	consumableMutex.Lock()
+	defer consumableMutex.Unlock()
+
+	if consumablesLoaded {
+		return nil // Already loaded
+	}
+
+	consumableCache = make(map[string]types.ConsumableData)
+
+	err := filepath.WalkDir(config.AssetPathsConfig.ConsumablesDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Skip directories and non-JSON files
+		if d.IsDir() || !strings.HasSuffix(strings.ToLower(d.Name()), ".json") {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read consumable file %s: %w", path, err)
+		}
+
+		var consumable types.ConsumableData
+		if err := json.Unmarshal(data, &consumable); err != nil {
+			return fmt.Errorf("failed to parse consumable file %s: %w", path, err)
+		}
+		if consumable.KeyName == "" {
+			return fmt.Errorf("consumable file %s has no KeyName", path)
+		}
+
+		consumableCache[consumable.KeyName] = consumable
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to load consumables: %w", err)
+	}
+
+	consumablesLoaded = true
+	return nil
+}
+
+// GetConsumable retrieves a consumable by its key name
+func GetConsumable(keyName string) (types.ConsumableData, bool) {
+	consumableMutex.RLock()
+	defer consumableMutex.RUnlock()
+
+	consumable, exists := consumableCache[keyName]
+	return consumable, exists
+}
+
+// NewConsumableItem returns an inventory stack of the consumable, loading consumables if needed
+func NewConsumableItem(keyName string, quantity int) (types.Item, bool) {
+	if err := LoadConsumables(); err != nil {
+		return types.Item{}, false
+	}
+
+	consumable, exists := GetConsumable(keyName)
+	if !exists {
+		return types.Item{}, false
+	}
+	return consumable.NewItem(quantity), true
+}
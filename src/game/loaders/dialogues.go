@@ -0,0 +1,141 @@
+package loaders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/game/types"
+)
+
+var (
+	dialogueCache   map[string]types.DialogueGraph
+	dialogueMutex   sync.RWMutex
+	dialoguesLoaded bool = false
+)
+
+// LoadDialogues loads all dialogue graphs from JSON files in assets/data/dialogues directory
+func LoadDialogues() error {
+	dialogueMutex.Lock()
+	defer dialogueMutex.Unlock()
+
+	if dialoguesLoaded {
+		return nil // Already loaded
+	}
+
+	dialogueCache = make(map[string]types.DialogueGraph)
+
+	err := filepath.WalkDir(config.AssetPathsConfig.DialoguesDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Skip directories and non-JSON files
+		if d.IsDir() || !strings.HasSuffix(strings.ToLower(d.Name()), ".json") {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read dialogue file %s: %w", path, err)
+		}
+
+		var graph types.DialogueGraph
+		if err := json.Unmarshal(data, &graph); err != nil {
+			return fmt.Errorf("failed to parse dialogue file %s: %w", path, err)
+		}
+		if graph.KeyName == "" {
+			return fmt.Errorf("dialogue file %s has no KeyName", path)
+		}
+		if err := validateDialogue(graph); err != nil {
+			return fmt.Errorf("dialogue file %s: %w", path, err)
+		}
+
+		dialogueCache[graph.KeyName] = graph
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to load dialogues: %w", err)
+	}
+
+	dialoguesLoaded = true
+	return nil
+}
+
+// GetDialogue retrieves a dialogue graph by its key name, loading graphs if needed
+func GetDialogue(keyName string) (types.DialogueGraph, bool) {
+	if err := LoadDialogues(); err != nil {
+		return types.DialogueGraph{}, false
+	}
+
+	dialogueMutex.RLock()
+	defer dialogueMutex.RUnlock()
+
+	graph, exists := dialogueCache[keyName]
+	return graph, exists
+}
+
+// validateDialogue checks that every node a graph links to exists and that its conditions and actions are known
+func validateDialogue(graph types.DialogueGraph) error {
+	if _, exists := graph.Nodes[graph.Start]; !exists {
+		return fmt.Errorf("start node %q does not exist", graph.Start)
+	}
+
+	checkNext := func(id, next string) error {
+		if _, exists := graph.Nodes[next]; next != "" && !exists {
+			return fmt.Errorf("node %q leads to unknown node %q", id, next)
+		}
+		return nil
+	}
+	checkConditions := func(id string, conditions []types.DialogueCondition) error {
+		for _, condition := range conditions {
+			if !types.IsConditionKind(condition.Kind) {
+				return fmt.Errorf("node %q has an unknown condition %q", id, condition.Kind)
+			}
+		}
+		return nil
+	}
+	checkActions := func(id string, actions []types.DialogueAction) error {
+		for _, action := range actions {
+			if !types.IsActionKind(action.Kind) {
+				return fmt.Errorf("node %q has an unknown action %q", id, action.Kind)
+			}
+		}
+		return nil
+	}
+
+	for id, node := range graph.Nodes {
+		if err := checkNext(id, node.Next); err != nil {
+			return err
+		}
+		if err := checkActions(id, node.Actions); err != nil {
+			return err
+		}
+		for _, branch := range node.Branches {
+			if err := checkNext(id, branch.Next); err != nil {
+				return err
+			}
+			if err := checkConditions(id, branch.Conditions); err != nil {
+				return err
+			}
+		}
+		for _, choice := range node.Choices {
+			if err := checkNext(id, choice.Next); err != nil {
+				return err
+			}
+			if err := checkConditions(id, choice.Conditions); err != nil {
+				return err
+			}
+			if err := checkActions(id, choice.Actions); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
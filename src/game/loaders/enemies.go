@@ -0,0 +1,113 @@
+package loaders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/game/types"
+)
+
+var (
+	enemyCache    map[string]types.EnemyArchetype
+	enemyMutex    sync.RWMutex
+	enemiesLoaded bool = false
+)
+
+// LoadEnemies loads all enemy archetypes from JSON files in assets/data/enemies directory
+func LoadEnemies() error {
+	enemyMutex.Lock()
+	defer enemyMutex.Unlock()
+
+	if enemiesLoaded {
+		return nil // Already loaded
+	}
+
+	enemyCache = make(map[string]types.EnemyArchetype)
+
+	err := filepath.WalkDir(config.AssetPathsConfig.EnemiesDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Skip directories and non-JSON files
+		if d.IsDir() || !strings.HasSuffix(strings.ToLower(d.Name()), ".json") {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read enemy file %s: %w", path, err)
+		}
+
+		var archetype types.EnemyArchetype
+		if err := json.Unmarshal(data, &archetype); err != nil {
+			return fmt.Errorf("failed to parse enemy file %s: %w", path, err)
+		}
+		if archetype.KeyName == "" {
+			return fmt.Errorf("enemy file %s has no KeyName", path)
+		}
+		if archetype.MaxHP <= 0 {
+			return fmt.Errorf("enemy file %s has no MaxHP", path)
+		}
+		for _, action := range archetype.AI.Actions {
+			if !types.IsEnemyAction(action.Action) {
+				return fmt.Errorf("enemy file %s has an unknown AI action %q", path, action.Action)
+			}
+		}
+
+		enemyCache[archetype.KeyName] = archetype
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to load enemies: %w", err)
+	}
+
+	enemiesLoaded = true
+	return nil
+}
+
+// GetEnemy retrieves an enemy archetype by its key name, loading archetypes if needed
+func GetEnemy(keyName string) (types.EnemyArchetype, bool) {
+	if err := LoadEnemies(); err != nil {
+		return types.EnemyArchetype{}, false
+	}
+
+	enemyMutex.RLock()
+	defer enemyMutex.RUnlock()
+
+	archetype, exists := enemyCache[keyName]
+	return archetype, exists
+}
+
+// LootItem returns the item given by a loot entry, whose key names a consumable or a weapon
+func LootItem(entry types.LootEntry) (types.Item, bool) {
+	if item, ok := NewConsumableItem(entry.Item, max(entry.Quantity, 1)); ok {
+		return item, true
+	}
+	return NewWeaponItem(entry.Item)
+}
+
+// resolveSpawns fills the enemies of every stage of a world that reference an archetype
+func resolveSpawns(world *types.World) error {
+	for i := range world.Stages {
+		stage := &world.Stages[i]
+		for j, spawn := range stage.Enemies {
+			if spawn.Archetype == "" {
+				continue
+			}
+			archetype, exists := GetEnemy(spawn.Archetype)
+			if !exists {
+				return fmt.Errorf("stage %d spawns unknown enemy archetype %q", stage.StageNb, spawn.Archetype)
+			}
+			stage.Enemies[j] = archetype.Spawn(spawn)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package loaders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/game/types"
+)
+
+var (
+	implantCache   map[string]types.Implant
+	implantMutex   sync.RWMutex
+	implantsLoaded bool = false
+)
+
+// LoadImplants loads all implants from JSON files in assets/data/implants directory
+func LoadImplants() error {
+	implantMutex.Lock()
+	defer implantMutex.Unlock()
+
+	if implantsLoaded {
+		return nil // Already loaded
+	}
+
+	implantCache = make(map[string]types.Implant)
+
+	err := filepath.WalkDir(config.AssetPathsConfig.ImplantsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Skip directories and non-JSON files
+		if d.IsDir() || !strings.HasSuffix(strings.ToLower(d.Name()), ".json") {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read implant file %s: %w", path, err)
+		}
+
+		var implant types.Implant
+		if err := json.Unmarshal(data, &implant); err != nil {
+			return fmt.Errorf("failed to parse implant file %s: %w", path, err)
+		}
+		if implant.KeyName == "" {
+			return fmt.Errorf("implant file %s has no KeyName", path)
+		}
+		if implant.Type < types.Head || implant.Type > types.Legs {
+			return fmt.Errorf("implant file %s has an unknown body slot %d", path, implant.Type)
+		}
+
+		implantCache[implant.KeyName] = implant
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to load implants: %w", err)
+	}
+
+	implantsLoaded = true
+	return nil
+}
+
+// GetImplant retrieves an implant by its key name, loading implants if needed
+func GetImplant(keyName string) (types.Implant, bool) {
+	if err := LoadImplants(); err != nil {
+		return types.Implant{}, false
+	}
+
+	implantMutex.RLock()
+	defer implantMutex.RUnlock()
+
+	implant, exists := implantCache[keyName]
+	return implant, exists
+}
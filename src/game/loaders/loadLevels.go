@@ -24,6 +24,7 @@ import (
 	"strings"
 	"sync"
 
+	"projectred-rpg.com/config"
 	"projectred-rpg.com/game/types"
 )
 
@@ -44,11 +45,8 @@ func LoadWorlds() error {
 
 	worldCache = make(map[int]types.World)
 
-	// Get the path to the assets/levels directory
-	levelsPath := filepath.Join("assets", "levels")
-
-	// Read all JSON files in the directory
-	err := filepath.WalkDir(levelsPath, func(path string, d fs.DirEntry, err error) error {
+	// Read all JSON files in the worlds directory
+	err := filepath.WalkDir(config.AssetPathsConfig.WorldsDir, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
@@ -75,6 +73,10 @@ func LoadWorlds() error {
 			world.Stages[i].WorldID = world.WorldID
 		}
 
+		if err := resolveSpawns(&world); err != nil {
+			return fmt.Errorf("failed to resolve enemies of world file %s: %w", path, err)
+		}
+
 		// Store the world in the cache using its WorldID
 		worldCache[world.WorldID] = world
 
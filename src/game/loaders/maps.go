@@ -2,21 +2,28 @@ package loaders
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
+	"projectred-rpg.com/config"
 	"projectred-rpg.com/game/types"
 )
 
+// mapHeaderEnd separates the metadata header from the tiles in a .map file
+const mapHeaderEnd = "---"
+
 // LoadStageMap tries to load a map file for a given world and stage.
 // Files are expected under assets/levels as world-<id>_stage-<nb>.map.
 // Returns nil if not found or on error (caller can fallback to empty background).
+// Bad header directives are skipped and listed in Warnings, the rest of the map still loads.
 func LoadStageMap(worldID, stageNb int) *types.TileMap {
 	// Construct filename like: assets/levels/world-1_stage-1.map
 	fileName := fmt.Sprintf("world-%d_stage-%d.map", worldID, stageNb)
-	mapPath := filepath.Join("assets", "levels", fileName)
+	mapPath := filepath.Join(config.AssetPathsConfig.LevelsDir, fileName)
 
 	f, err := os.Open(mapPath)
 	if err != nil {
@@ -32,42 +39,305 @@ func LoadStageMap(worldID, stageNb int) *types.TileMap {
 	if err := scanner.Err(); err != nil {
 		return nil
 	}
-	if len(lines) == 0 {
+
+	tm, err := ParseStageMap(lines)
+	if tm == nil {
 		return nil
 	}
+	if err != nil {
+		warn("Skipped directives of %s: %v", fileName, err)
+	}
 
-	tm := types.NewTileMap(lines)
+	// Glyph properties come from the world definition
+	if world, exists := GetWorld(worldID); exists {
+		tm.Legend = world.Legend
+	}
+	return tm
+}
+
+// ParseStageMap builds a TileMap from the lines of a .map file.
+//
+// A map may start with a metadata header ended by a "---" line. Each header line is a
+// directive followed by key=value attributes, values can be double quoted:
+//
+//	spawn x=13 y=31
+//	exit x=4 y=1 w=16 h=2 world=1 stage=2
+//	exit x=2 y=5 w=2 h=3 world=2 stage=1 quest=scout_plaza
+//	enemy ref="Rogue Drone" x=50 y=30
+//	npc id=vendor type=merchant x=20 y=8 name="Old Joe" shop=ripperdoc
+//	npc id=fixer type=villager x=40 y=12 name="game.npcs.fixer" dialogue=fixer
+//	region name=market x=10 y=5 w=20 h=8
+//	chest id=crate x=30 y=20 items="small_medkit:2,katana" credits=25
+//
+// Blank lines and lines starting with '#' are ignored in the header.
+// Files without a "---" line are tiles only.
+//
+// A bad directive is skipped: the map is returned with an error listing the skipped lines.
+// The map is nil only when the file has no tiles.
+func ParseStageMap(lines []string) (*types.TileMap, error) {
+	header, tiles := splitMapHeader(lines)
+	if len(tiles) == 0 {
+		return nil, fmt.Errorf("map has no tiles")
+	}
 
-	// Apply custom transition zone coordinates if available
-	if tm != nil {
-		if x, y, w, h, hasCustom := getCustomTransitionZone(worldID, stageNb); hasCustom {
-			tm.SetCustomTransitionZone(x, y, w, h)
+	tm := types.NewTileMap(tiles)
+	var errs []error
+	for i, line := range header {
+		if err := applyMapDirective(tm, line); err != nil {
+			errs = append(errs, fmt.Errorf("map header line %d: %w", i+1, err))
 		}
 	}
+	return tm, errors.Join(errs...)
+}
 
-	return tm
+// splitMapHeader returns the header lines and the tile lines of a map file
+func splitMapHeader(lines []string) (header, tiles []string) {
+	for i, line := range lines {
+		if strings.TrimSpace(line) == mapHeaderEnd {
+			return lines[:i], lines[i+1:]
+		}
+	}
+	return nil, lines
+}
+
+// applyMapDirective parses one header line into the tile map
+func applyMapDirective(tm *types.TileMap, line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+
+	fields, err := splitDirective(line)
+	if err != nil {
+		return err
+	}
+	attrs, err := parseAttributes(fields[1:])
+	if err != nil {
+		return err
+	}
+
+	switch fields[0] {
+	case "spawn":
+		pos, err := attrs.position()
+		if err != nil {
+			return err
+		}
+		tm.PlayerSpawn = &pos
+
+	case "exit":
+		x, y, w, h, err := attrs.rect()
+		if err != nil {
+			return err
+		}
+		zone := &types.TransitionZone{X: x, Y: y, Width: w, Height: h, Quest: attrs["quest"]}
+		if zone.TargetWorld, err = attrs.optionalInt("world"); err != nil {
+			return err
+		}
+		if zone.TargetStage, err = attrs.optionalInt("stage"); err != nil {
+			return err
+		}
+		tm.TransitionZones = append(tm.TransitionZones, zone)
+
+	case "enemy":
+		ref, err := attrs.required("ref")
+		if err != nil {
+			return err
+		}
+		pos, err := attrs.position()
+		if err != nil {
+			return err
+		}
+		tm.EnemyMarkers = append(tm.EnemyMarkers, types.EnemyMarker{Ref: ref, Pos: pos})
+
+	case "npc":
+		id, err := attrs.required("id")
+		if err != nil {
+			return err
+		}
+		pos, err := attrs.position()
+		if err != nil {
+			return err
+		}
+		npc := types.NPCPlacement{
+			ID:     id,
+			Name:   attrs["name"],
+			Type:   types.NPCType(attrs["type"]),
+			Pos:    pos,
+			Sprite: attrs["sprite"],
+			Props:  make(map[string]string),
+		}
+		for key, value := range attrs {
+			switch key {
+			case "id", "name", "type", "x", "y", "sprite":
+			default:
+				npc.Props[key] = value
+			}
+		}
+		tm.NPCs = append(tm.NPCs, npc)
+
+	case "chest":
+		id, err := attrs.required("id")
+		if err != nil {
+			return err
+		}
+		pos, err := attrs.position()
+		if err != nil {
+			return err
+		}
+		chest := types.Chest{ID: id, Pos: pos}
+		if chest.Contents, err = parseChestContents(attrs["items"]); err != nil {
+			return err
+		}
+		if chest.Credits, err = attrs.optionalInt("credits"); err != nil {
+			return err
+		}
+		tm.Chests = append(tm.Chests, chest)
+
+	case "region":
+		name, err := attrs.required("name")
+		if err != nil {
+			return err
+		}
+		x, y, w, h, err := attrs.rect()
+		if err != nil {
+			return err
+		}
+		tm.Regions = append(tm.Regions, types.Region{Name: name, X: x, Y: y, Width: w, Height: h})
+
+	default:
+		return fmt.Errorf("unknown directive %q", fields[0])
+	}
+	return nil
+}
+
+// splitDirective splits a header line on spaces, keeping double quoted values together
+func splitDirective(line string) ([]string, error) {
+	var fields []string
+	var current strings.Builder
+	inQuotes := false
+
+	for _, r := range line {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case (r == ' ' || r == '\t') && !inQuotes:
+			if current.Len() > 0 {
+				fields = append(fields, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if current.Len() > 0 {
+		fields = append(fields, current.String())
+	}
+	return fields, nil
+}
+
+// parseChestContents reads a comma separated list of item keys, each optionally followed by :quantity
+func parseChestContents(value string) ([]types.LootEntry, error) {
+	var contents []types.LootEntry
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		key, quantity, hasQuantity := strings.Cut(field, ":")
+		entry := types.LootEntry{Item: key, Quantity: 1}
+		if hasQuantity {
+			n, err := strconv.Atoi(quantity)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid quantity for %s %q", key, quantity)
+			}
+			entry.Quantity = n
+		}
+		contents = append(contents, entry)
+	}
+	return contents, nil
 }
 
-// getCustomTransitionZone returns custom transition zone coordinates for specific world/stage combinations
-func getCustomTransitionZone(worldID, stageNb int) (x, y, width, height int, hasCustom bool) {
-	switch worldID {
-	case 1:
-		switch stageNb {
-		case 1:
-			return 4, 1, 16, 2, true // Custom position for world 1, stage 1
-		case 2:
-			return 2, 5, 2, 3, true // Custom position for world 1, stage 2
-		case 3:
-			return 20, 5, 2, 3, true // Custom position for world 1, stage 3
-		}
-	case 2:
-		switch stageNb {
-		case 1:
-			return 15, 12, 2, 2, true // Custom position for world 2, stage 1
-		case 2:
-			return 8, 6, 3, 2, true // Custom position for world 2, stage 2
-		}
-	}
-	// Return false if no custom coordinates defined for this world/stage
-	return 0, 0, 0, 0, false
+// mapAttributes holds the key=value pairs of a header directive
+type mapAttributes map[string]string
+
+// parseAttributes turns key=value fields into attributes, unquoting values
+func parseAttributes(fields []string) (mapAttributes, error) {
+	attrs := make(mapAttributes, len(fields))
+	for _, field := range fields {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("expected key=value, got %q", field)
+		}
+		if strings.HasPrefix(value, `"`) {
+			unquoted, err := strconv.Unquote(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %w", key, err)
+			}
+			value = unquoted
+		}
+		attrs[key] = value
+	}
+	return attrs, nil
+}
+
+// required returns a mandatory attribute
+func (a mapAttributes) required(key string) (string, error) {
+	value, ok := a[key]
+	if !ok || value == "" {
+		return "", fmt.Errorf("missing %s", key)
+	}
+	return value, nil
+}
+
+// requiredInt returns a mandatory integer attribute
+func (a mapAttributes) requiredInt(key string) (int, error) {
+	value, err := a.required(key)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", key, value)
+	}
+	return n, nil
+}
+
+// optionalInt returns an integer attribute, 0 when absent
+func (a mapAttributes) optionalInt(key string) (int, error) {
+	if _, ok := a[key]; !ok {
+		return 0, nil
+	}
+	return a.requiredInt(key)
+}
+
+// position reads the x and y attributes
+func (a mapAttributes) position() (types.Position, error) {
+	x, err := a.requiredInt("x")
+	if err != nil {
+		return types.Position{}, err
+	}
+	y, err := a.requiredInt("y")
+	if err != nil {
+		return types.Position{}, err
+	}
+	return types.Position{X: x, Y: y}, nil
+}
+
+// rect reads the x, y, w and h attributes
+func (a mapAttributes) rect() (x, y, w, h int, err error) {
+	pos, err := a.position()
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if w, err = a.requiredInt("w"); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if h, err = a.requiredInt("h"); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	return pos.X, pos.Y, w, h, nil
 }
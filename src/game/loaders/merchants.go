@@ -0,0 +1,107 @@
+package loaders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/game/types"
+)
+
+var (
+	merchantCache   map[string]types.MerchantCatalog
+	merchantMutex   sync.RWMutex
+	merchantsLoaded bool = false
+)
+
+// LoadMerchants loads all merchant catalogs from JSON files in assets/data/merchants directory
+func LoadMerchants() error {
+	merchantMutex.Lock()
+	defer merchantMutex.Unlock()
+
+	if merchantsLoaded {
+		return nil // Already loaded
+	}
+
+	merchantCache = make(map[string]types.MerchantCatalog)
+
+	err := filepath.WalkDir(config.AssetPathsConfig.MerchantsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Skip directories and non-JSON files
+		if d.IsDir() || !strings.HasSuffix(strings.ToLower(d.Name()), ".json") {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read merchant file %s: %w", path, err)
+		}
+
+		var catalog types.MerchantCatalog
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return fmt.Errorf("failed to parse merchant file %s: %w", path, err)
+		}
+		if catalog.KeyName == "" {
+			return fmt.Errorf("merchant file %s has no KeyName", path)
+		}
+
+		merchantCache[catalog.KeyName] = catalog
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to load merchants: %w", err)
+	}
+
+	merchantsLoaded = true
+	return nil
+}
+
+// GetMerchant retrieves a merchant catalog by its key name, loading catalogs if needed
+func GetMerchant(keyName string) (types.MerchantCatalog, bool) {
+	if err := LoadMerchants(); err != nil {
+		return types.MerchantCatalog{}, false
+	}
+
+	merchantMutex.RLock()
+	defer merchantMutex.RUnlock()
+
+	catalog, exists := merchantCache[keyName]
+	return catalog, exists
+}
+
+// CatalogItem returns the item sold by a catalog entry and its base price
+func CatalogItem(entry types.CatalogEntry) (types.Item, int, bool) {
+	if entry.Consumable != "" {
+		item, ok := NewConsumableItem(entry.Consumable, 1)
+		if !ok {
+			return types.Item{}, 0, false
+		}
+		return item, item.Value, true
+	}
+
+	if entry.Weapon != "" {
+		item, ok := NewWeaponItem(entry.Weapon)
+		if !ok {
+			return types.Item{}, 0, false
+		}
+		return item, item.Value, true
+	}
+
+	if entry.Implant != "" {
+		implant, ok := GetImplant(entry.Implant)
+		if !ok {
+			return types.Item{}, 0, false
+		}
+		return implant.Item(), implant.Price, true
+	}
+	return types.Item{}, 0, false
+}
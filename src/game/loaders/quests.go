@@ -0,0 +1,107 @@
+package loaders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/game/types"
+)
+
+var (
+	questCache   map[string]types.Quest
+	questMutex   sync.RWMutex
+	questsLoaded bool = false
+)
+
+// LoadQuests loads all quests from JSON files in assets/data/quests directory
+func LoadQuests() error {
+	questMutex.Lock()
+	defer questMutex.Unlock()
+
+	if questsLoaded {
+		return nil // Already loaded
+	}
+
+	questCache = make(map[string]types.Quest)
+
+	err := filepath.WalkDir(config.AssetPathsConfig.QuestsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Skip directories and non-JSON files
+		if d.IsDir() || !strings.HasSuffix(strings.ToLower(d.Name()), ".json") {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read quest file %s: %w", path, err)
+		}
+
+		var quest types.Quest
+		if err := json.Unmarshal(data, &quest); err != nil {
+			return fmt.Errorf("failed to parse quest file %s: %w", path, err)
+		}
+		if quest.KeyName == "" {
+			return fmt.Errorf("quest file %s has no KeyName", path)
+		}
+		if len(quest.Objectives) == 0 {
+			return fmt.Errorf("quest file %s has no objectives", path)
+		}
+		for _, objective := range quest.Objectives {
+			if !types.IsObjectiveKind(objective.Kind) {
+				return fmt.Errorf("quest file %s has an unknown objective %q", path, objective.Kind)
+			}
+		}
+
+		questCache[quest.KeyName] = quest
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to load quests: %w", err)
+	}
+
+	questsLoaded = true
+	return nil
+}
+
+// GetQuest retrieves a quest by its key name, loading quests if needed
+func GetQuest(keyName string) (types.Quest, bool) {
+	if err := LoadQuests(); err != nil {
+		return types.Quest{}, false
+	}
+
+	questMutex.RLock()
+	defer questMutex.RUnlock()
+
+	quest, exists := questCache[keyName]
+	return quest, exists
+}
+
+// GetQuests returns every quest sorted by key name, loading quests if needed
+func GetQuests() []types.Quest {
+	if err := LoadQuests(); err != nil {
+		return nil
+	}
+
+	questMutex.RLock()
+	defer questMutex.RUnlock()
+
+	quests := make([]types.Quest, 0, len(questCache))
+	for _, quest := range questCache {
+		quests = append(quests, quest)
+	}
+	sort.Slice(quests, func(i, j int) bool {
+		return quests[i].KeyName < quests[j].KeyName
+	})
+	return quests
+}
@@ -0,0 +1,23 @@
+package loaders
+
+import (
+	"fmt"
+	"slices"
+)
+
+// warnings are the problems found while loading assets that did not stop the load.
+// They are kept for the debug screen, printing them would draw over the game.
+var warnings []string
+
+// warn records a loading problem once, however many times the asset is loaded
+func warn(format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	if !slices.Contains(warnings, message) {
+		warnings = append(warnings, message)
+	}
+}
+
+// Warnings returns the problems found while loading assets, oldest first
+func Warnings() []string {
+	return warnings
+}
@@ -0,0 +1,88 @@
+package loaders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/game/types"
+)
+
+var (
+	weaponCache   map[string]types.WeaponData
+	weaponMutex   sync.RWMutex
+	weaponsLoaded bool = false
+)
+
+// LoadWeapons loads all weapons from JSON files in assets/data/weapons directory
+func LoadWeapons() error {
+	weaponMutex.Lock()
+	defer weaponMutex.Unlock()
+
+	if weaponsLoaded {
+		return nil // Already loaded
+	}
+
+	weaponCache = make(map[string]types.WeaponData)
+
+	err := filepath.WalkDir(config.AssetPathsConfig.WeaponsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Skip directories and non-JSON files
+		if d.IsDir() || !strings.HasSuffix(strings.ToLower(d.Name()), ".json") {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read weapon file %s: %w", path, err)
+		}
+
+		var weapon types.WeaponData
+		if err := json.Unmarshal(data, &weapon); err != nil {
+			return fmt.Errorf("failed to parse weapon file %s: %w", path, err)
+		}
+		if weapon.KeyName == "" {
+			return fmt.Errorf("weapon file %s has no KeyName", path)
+		}
+
+		weaponCache[weapon.KeyName] = weapon
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to load weapons: %w", err)
+	}
+
+	weaponsLoaded = true
+	return nil
+}
+
+// GetWeapon retrieves a weapon by its key name
+func GetWeapon(keyName string) (types.WeaponData, bool) {
+	weaponMutex.RLock()
+	defer weaponMutex.RUnlock()
+
+	weapon, exists := weaponCache[keyName]
+	return weapon, exists
+}
+
+// NewWeaponItem returns the inventory item of a weapon, loading weapons if needed
+func NewWeaponItem(keyName string) (types.Item, bool) {
+	if err := LoadWeapons(); err != nil {
+		return types.Item{}, false
+	}
+
+	weapon, exists := GetWeapon(keyName)
+	if !exists {
+		return types.Item{}, false
+	}
+	return weapon.NewItem(), true
+}
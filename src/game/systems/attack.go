@@ -0,0 +1,138 @@
+package systems
+
+import (
+	"math/rand"
+
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/types"
+)
+
+// ResolveAttack rolls an attack against a target: hit chance, damage variance, critical hit and resistances.
+// Every random draw comes from rng so a seeded source replays the same fight.
+func ResolveAttack(rng *rand.Rand, roll types.AttackRoll, target types.AttackTarget) types.AttackResult {
+	result := types.AttackResult{HitChance: HitChance(roll.Accuracy, target.Speed)}
+	if rng.Intn(100) >= result.HitChance {
+		return result
+	}
+
+	damage := max(roll.Power-target.Defense, 1)
+	variance := config.DamageVariancePercent
+	damage = percentOf(damage, 100+rng.Intn(2*variance+1)-variance)
+
+	result.Outcome = types.AttackHit
+	if rng.Intn(100) < CritChance(roll.Accuracy) {
+		result.Outcome = types.AttackCritical
+		damage = percentOf(damage, config.CritDamagePercent)
+	}
+
+	if resistance := target.Resistances[damageType(roll.DamageType)]; resistance != 0 {
+		resisted := percentOf(damage, resistance)
+		result.Resisted = resisted
+		damage -= resisted
+	}
+
+	result.Damage = max(damage, 1)
+	result.Effects = roll.Effects
+	return result
+}
+
+// HitChance returns the percent chance an attack with accuracy lands on a target with speed
+func HitChance(accuracy, speed int) int {
+	chance := config.BaseHitChance + (accuracy-speed)*config.HitChancePerPoint
+	return min(max(chance, config.MinHitChance), config.MaxHitChance)
+}
+
+// CritChance returns the percent chance a landed attack with accuracy is a critical hit
+func CritChance(accuracy int) int {
+	chance := config.BaseCritChance + max(accuracy, 0)/config.CritChancePerAccuracy
+	return min(chance, config.MaxCritChance)
+}
+
+// percentOf returns percent of value, rounded half away from zero
+func percentOf(value, percent int) int {
+	product := value * percent
+	if product < 0 {
+		return -((-product + 50) / 100)
+	}
+	return (product + 50) / 100
+}
+
+// damageType reads an unset damage type as physical
+func damageType(dt types.DamageType) types.DamageType {
+	if dt == "" {
+		return types.DamagePhysical
+	}
+	return dt
+}
+
+// playerRoll builds an attack of the player from their effective stats, power adds to their force
+func playerRoll(p *types.Player, power int, dt types.DamageType, effects []types.ItemEffect) types.AttackRoll {
+	stats := p.EffectiveStats()
+	return types.AttackRoll{
+		Power:      stats.Force + power,
+		Accuracy:   stats.Accuracy,
+		DamageType: dt,
+		Effects:    effects,
+	}
+}
+
+// playerTarget returns the player's effective defensive stats
+func playerTarget(p *types.Player) types.AttackTarget {
+	stats := p.EffectiveStats()
+	return types.AttackTarget{Speed: stats.Speed, Defense: stats.Defense}
+}
+
+// enemyRoll builds an attack of an enemy with its statuses, accuracyMalus makes riskier attacks miss more often
+func enemyRoll(e *entities.Enemy, power, accuracyMalus int) types.AttackRoll {
+	return types.AttackRoll{
+		Power:    power + e.Statuses.StatBonus("Force"),
+		Accuracy: e.Accuracy + e.Statuses.StatBonus("Accuracy") - accuracyMalus,
+	}
+}
+
+// enemyTarget returns the defensive stats of an enemy with its statuses
+func enemyTarget(e *entities.Enemy) types.AttackTarget {
+	return types.AttackTarget{
+		Speed:       e.Speed + e.Statuses.StatBonus("Speed"),
+		Defense:     e.Defense + e.Statuses.StatBonus("Defense"),
+		Resistances: e.Resistances,
+	}
+}
+
+// attackText describes a resolved basic attack
+func (cs *CombatSystem) attackText(attacker, defender string, result types.AttackResult) string {
+	switch result.Outcome {
+	case types.AttackMiss:
+		return cs.locManager.Text("ui.combat.miss", attacker, defender)
+	case types.AttackCritical:
+		return cs.locManager.Text("ui.combat.critical", attacker, result.Damage, defender)
+	default:
+		return cs.locManager.Text("ui.combat.damage", attacker, result.Damage, defender)
+	}
+}
+
+// logAttack adds a resolved attack to the combat history
+func (cs *CombatSystem) logAttack(actor, actionType, target string, result types.AttackResult, message string) {
+	if cs.combatUI != nil {
+		cs.combatUI.AddAttack(actor, actionType, target, result, message)
+	}
+}
+
+// hitPlayer takes the damage of a resolved enemy attack from the player's health, then runs their status hit hooks
+func (cs *CombatSystem) hitPlayer(p *types.Player, result types.AttackResult) {
+	p.Stats.CurrentHP = max(p.Stats.CurrentHP-result.Damage, 0)
+	if result.Landed() && p.Stats.CurrentHP > 0 {
+		cs.statusHit(playerCombatant(p))
+	}
+}
+
+// landPlayerAttack applies the effects of a resolved player attack that hit, then its damage
+func (cs *CombatSystem) landPlayerAttack(e *entities.Enemy, p *types.Player, result types.AttackResult) {
+	if result.Landed() && e.CurrentHP > result.Damage {
+		for _, effect := range result.Effects {
+			cs.logAction(p.Name, "Effect", cs.applyEffect(effect, p))
+		}
+	}
+	cs.resolvePlayerHit(e, p, result.Damage)
+}
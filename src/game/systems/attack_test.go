@@ -0,0 +1,194 @@
+package systems
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/game/types"
+)
+
+// attackRolls is the number of attacks resolved to measure rates
+const attackRolls = 20000
+
+// rateTolerance is how far, in percent points, a measured rate may stray from its expected value
+const rateTolerance = 1.5
+
+func TestHitChance(t *testing.T) {
+	tests := []struct {
+		accuracy, speed int
+		want            int
+	}{
+		{10, 10, config.BaseHitChance},
+		{15, 10, config.BaseHitChance + 5*config.HitChancePerPoint},
+		{10, 15, config.BaseHitChance - 5*config.HitChancePerPoint},
+		{100, 0, config.MaxHitChance},
+		{0, 100, config.MinHitChance},
+	}
+	for _, tt := range tests {
+		if got := HitChance(tt.accuracy, tt.speed); got != tt.want {
+			t.Errorf("HitChance(%d, %d) = %d, want %d", tt.accuracy, tt.speed, got, tt.want)
+		}
+	}
+}
+
+func TestCritChance(t *testing.T) {
+	tests := []struct {
+		accuracy int
+		want     int
+	}{
+		{0, config.BaseCritChance},
+		{-10, config.BaseCritChance},
+		{20, config.BaseCritChance + 20/config.CritChancePerAccuracy},
+		{1000, config.MaxCritChance},
+	}
+	for _, tt := range tests {
+		if got := CritChance(tt.accuracy); got != tt.want {
+			t.Errorf("CritChance(%d) = %d, want %d", tt.accuracy, got, tt.want)
+		}
+	}
+}
+
+// outcomeRates resolves attackRolls attacks with a fixed seed and returns the percent of misses, hits and crits
+func outcomeRates(roll types.AttackRoll, target types.AttackTarget) map[types.AttackOutcome]float64 {
+	rng := rand.New(rand.NewSource(42))
+	counts := make(map[types.AttackOutcome]int)
+	for range attackRolls {
+		counts[ResolveAttack(rng, roll, target).Outcome]++
+	}
+
+	rates := make(map[types.AttackOutcome]float64)
+	for outcome, count := range counts {
+		rates[outcome] = float64(count) * 100 / attackRolls
+	}
+	return rates
+}
+
+func TestResolveAttackRates(t *testing.T) {
+	tests := []struct {
+		name     string
+		accuracy int
+		speed    int
+	}{
+		{"even", 10, 10},
+		{"accurate", 25, 10},
+		{"evasive target", 5, 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rates := outcomeRates(types.AttackRoll{Power: 20, Accuracy: tt.accuracy}, types.AttackTarget{Speed: tt.speed})
+
+			hit := float64(HitChance(tt.accuracy, tt.speed))
+			crit := hit * float64(CritChance(tt.accuracy)) / 100
+			want := map[types.AttackOutcome]float64{
+				types.AttackMiss:     100 - hit,
+				types.AttackHit:      hit - crit,
+				types.AttackCritical: crit,
+			}
+			for outcome, rate := range want {
+				if math.Abs(rates[outcome]-rate) > rateTolerance {
+					t.Errorf("outcome %d happened %.2f%% of the time, want %.2f%%", outcome, rates[outcome], rate)
+				}
+			}
+		})
+	}
+}
+
+func TestResolveAttackDamageVariance(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	roll := types.AttackRoll{Power: 60, Accuracy: 100}
+	target := types.AttackTarget{Defense: 10}
+	base := roll.Power - target.Defense
+
+	low, high := percentOf(base, 100-config.DamageVariancePercent), percentOf(base, 100+config.DamageVariancePercent)
+	critHigh := percentOf(high, config.CritDamagePercent)
+	seen := make(map[int]bool)
+	for range attackRolls {
+		result := ResolveAttack(rng, roll, target)
+		switch result.Outcome {
+		case types.AttackHit:
+			if result.Damage < low || result.Damage > high {
+				t.Fatalf("hit dealt %d damage, want between %d and %d", result.Damage, low, high)
+			}
+			seen[result.Damage] = true
+		case types.AttackCritical:
+			if result.Damage < percentOf(low, config.CritDamagePercent) || result.Damage > critHigh {
+				t.Fatalf("critical hit dealt %d damage, want at most %d", result.Damage, critHigh)
+			}
+		}
+	}
+	if !seen[low] || !seen[high] {
+		t.Errorf("hits never reached the variance bounds %d and %d", low, high)
+	}
+}
+
+func TestResolveAttackMinimumDamage(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	roll := types.AttackRoll{Power: 5, Accuracy: 100}
+	for range 1000 {
+		result := ResolveAttack(rng, roll, types.AttackTarget{Defense: 50})
+		want := 1
+		if result.Outcome == types.AttackCritical {
+			want = percentOf(1, config.CritDamagePercent)
+		}
+		if result.Landed() && result.Damage != want {
+			t.Fatalf("attack %d against a stronger defense dealt %d damage, want %d", result.Outcome, result.Damage, want)
+		}
+	}
+}
+
+func TestResolveAttackResistances(t *testing.T) {
+	roll := types.AttackRoll{Power: 40, Accuracy: 20, DamageType: types.DamageEnergy}
+	tests := []struct {
+		name       string
+		resistance map[types.DamageType]int
+		percent    int // Percent of the damage resisted, negative when the target is weak to it
+	}{
+		{"resistant", map[types.DamageType]int{types.DamageEnergy: 50}, 50},
+		{"weak", map[types.DamageType]int{types.DamageEnergy: -50}, -50},
+		{"other type", map[types.DamageType]int{types.DamagePhysical: 50}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Resistances do not draw from the source, so both runs roll the same attacks
+			plainRng, resistRng := rand.New(rand.NewSource(3)), rand.New(rand.NewSource(3))
+			for range 500 {
+				plain := ResolveAttack(plainRng, roll, types.AttackTarget{})
+				resisted := ResolveAttack(resistRng, roll, types.AttackTarget{Resistances: tt.resistance})
+				if plain.Outcome != resisted.Outcome {
+					t.Fatalf("outcome %d became %d with resistances", plain.Outcome, resisted.Outcome)
+				}
+				want := percentOf(plain.Damage, tt.percent)
+				if resisted.Resisted != want {
+					t.Fatalf("resisted %d of %d damage, want %d", resisted.Resisted, plain.Damage, want)
+				}
+				if resisted.Landed() && resisted.Damage != max(plain.Damage-want, 1) {
+					t.Fatalf("took %d damage instead of %d for %d without resistances", resisted.Damage, plain.Damage-want, plain.Damage)
+				}
+			}
+		})
+	}
+}
+
+func TestResolveAttackPhysicalByDefault(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	target := types.AttackTarget{Resistances: map[types.DamageType]int{types.DamagePhysical: 100}}
+	for range 200 {
+		if result := ResolveAttack(rng, types.AttackRoll{Power: 30, Accuracy: 50}, target); result.Landed() && result.Resisted == 0 {
+			t.Fatal("attack without a damage type ignored the physical resistance")
+		}
+	}
+}
+
+func TestResolveAttackReplaysWithSameSeed(t *testing.T) {
+	roll := types.AttackRoll{Power: 25, Accuracy: 12}
+	target := types.AttackTarget{Speed: 11, Defense: 4}
+	first, second := rand.New(rand.NewSource(99)), rand.New(rand.NewSource(99))
+	for range 100 {
+		a, b := ResolveAttack(first, roll, target), ResolveAttack(second, roll, target)
+		if a.Outcome != b.Outcome || a.Damage != b.Damage {
+			t.Fatalf("same seed resolved %+v then %+v", a, b)
+		}
+	}
+}
@@ -3,9 +3,14 @@ package systems
 import (
 	"fmt"
 	"math/rand"
+	"strings"
+	"time"
 
+	"projectred-rpg.com/config"
 	"projectred-rpg.com/engine"
 	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/events"
+	"projectred-rpg.com/game/loaders"
 	"projectred-rpg.com/game/types"
 	"projectred-rpg.com/ui"
 )
@@ -14,42 +19,53 @@ import (
 type CombatSystem struct {
 	CurrentCombatState  types.CombatState
 	PreviousCombatState types.CombatState
-	CurrentEnemy        *entities.Enemy
+	CurrentEnemy        *entities.Enemy   // Enemy targeted by the player
+	Enemies             []*entities.Enemy // Every enemy taking part in the fight
 	locManager          *engine.LocalizationManager
 	spawnerSystem       *SpawnerSystem
+	lootSystem          *LootSystem
 	combatUI            *ui.CombatHud
-	enemyTurnDelay      int    // Frames to wait before processing enemy turn
-	maxEnemyTurnDelay   int    // Maximum delay for enemy turns
-	resultDisplayDelay  int    // Frames to wait before auto-exiting combat after victory/defeat
-	maxResultDelay      int    // Maximum delay for result display
-	onExitCallback      func() // Callback to refresh game state when exiting combat
+	enemyTurnDelay      time.Duration                  // Time left before processing enemy turn
+	maxEnemyTurnDelay   time.Duration                  // Delay before enemy turns
+	resultDisplayDelay  time.Duration                  // Time left before auto-exiting combat after victory/defeat
+	maxResultDelay      time.Duration                  // Delay during which the result is displayed
+	events              *events.Bus                    // Where fights, downed enemies and level ups are published
+	player              *types.Player                  // Player of the current fight, whose statuses end with it
+	turnOrder           []*entities.Enemy              // Initiative order of the round, nil is the player's turn
+	turnIndex           int                            // Position of the acting combatant in turnOrder
+	cooldowns           map[string]int                 // Rounds left before each weapon attack or implant ability is ready, by key
+	minds               map[*entities.Enemy]*enemyMind // What each enemy did during the fight, read by its AI profile
+	rng                 *rand.Rand                     // Source of every combat roll, seeded to replay fights
 }
 
 // NewCombatSystem creates a new combat system instance
-func NewCombatSystem(initialState types.CombatState, locManager *engine.LocalizationManager, spawnerSystem *SpawnerSystem) *CombatSystem {
+func NewCombatSystem(initialState types.CombatState, locManager *engine.LocalizationManager, spawnerSystem *SpawnerSystem, lootSystem *LootSystem, bus *events.Bus) *CombatSystem {
 	return &CombatSystem{
 		CurrentCombatState:  initialState,
 		PreviousCombatState: initialState,
 		locManager:          locManager,
 		spawnerSystem:       spawnerSystem,
+		lootSystem:          lootSystem,
+		events:              bus,
 		combatUI:            nil, // Will be initialized later when renderer is available
 		enemyTurnDelay:      0,
-		maxEnemyTurnDelay:   30, // Wait ~0.5 second at 60fps before enemy acts
+		maxEnemyTurnDelay:   500 * time.Millisecond, // Wait before enemy acts
 		resultDisplayDelay:  0,
-		maxResultDelay:      180, // Wait ~3 seconds at 60fps to show result
+		maxResultDelay:      3 * time.Second, // Wait to show result
+		rng:                 rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
+// SetRNG replaces the source of combat rolls, a seeded source makes fights reproducible
+func (cs *CombatSystem) SetRNG(rng *rand.Rand) {
+	cs.rng = rng
+}
+
 // SetRenderer sets the renderer and initializes the combat UI
 func (cs *CombatSystem) SetRenderer(renderer engine.Renderer) {
 	cs.combatUI = ui.NewCombatHud(renderer, cs.locManager)
 }
 
-// SetExitCallback sets a callback function to be called when combat exits
-func (cs *CombatSystem) SetExitCallback(callback func()) {
-	cs.onExitCallback = callback
-}
-
 func (cs *CombatSystem) ChangeCombatState(newState types.CombatState) {
 	cs.PreviousCombatState = cs.CurrentCombatState
 	cs.CurrentCombatState = newState
@@ -73,122 +89,84 @@ func (cs *CombatSystem) IsInCombat() bool {
 	return cs.CurrentCombatState != types.Idle && cs.CurrentCombatState != types.Dead
 }
 
-func (cs *CombatSystem) EnterCombat(e *entities.Enemy, p *types.Player) {
-	cs.CurrentEnemy = e
-	cs.ChangeCombatState(types.PlayerTurn)
+// EnterCombat starts a fight against a group of enemies, the fastest combatant acts first
+func (cs *CombatSystem) EnterCombat(enemies []*entities.Enemy, p *types.Player) {
+	if len(enemies) == 0 {
+		return
+	}
+
+	cs.Enemies = enemies
+	cs.CurrentEnemy = enemies[0]
+	cs.player = p
+	cs.cooldowns = make(map[string]int)
+	cs.minds = nil
+	cs.clearStatuses()
 
 	// Set up the combat UI if available
 	if cs.combatUI != nil {
-		cs.combatUI.SetCombatants(p, e)
-		cs.combatUI.UpdateState(types.PlayerTurn)
-		cs.combatUI.AddAction("System", "Combat", "", 0, fmt.Sprintf("Combat started against %s!", e.Name))
+		cs.combatUI.SetCombatants(p, enemies)
+		cs.refreshSkillActions(p)
+		names := make([]string, len(enemies))
+		for i, e := range enemies {
+			names[i] = e.Name
+		}
+		cs.combatUI.AddAction("System", "Combat", "", 0, fmt.Sprintf("Combat started against %s!", strings.Join(names, ", ")))
 	}
+
+	cs.events.Publish(events.CombatStarted{Enemies: enemies})
+	cs.rollInitiative(p)
+	cs.beginTurn()
 }
 
 func (cs *CombatSystem) AiAttack(e entities.Enemy, p *types.Player) {
-	// Calculate AI damage
-	baseDamage := e.Force
-	damage := baseDamage - p.Stats.Defense
-	if damage < 1 {
-		damage = 1
-	}
-
-	// Add some randomness to AI attacks
-	damage += rand.Intn(3) - 1 // -1 to +1 variation
-	if damage < 1 {
-		damage = 1
-	}
-
-	// Apply damage
-	p.Stats.CurrentHP -= damage
-	if p.Stats.CurrentHP < 0 {
-		p.Stats.CurrentHP = 0
-	}
-
-	// Log the action
-	message := fmt.Sprintf("%s attacks %s for %d damage!", e.Name, p.Name, damage)
-	if cs.combatUI != nil {
-		cs.combatUI.AddAction(e.Name, "Attack", p.Name, damage, message)
-	}
+	result := ResolveAttack(cs.rng, enemyRoll(&e, e.Force, 0), playerTarget(p))
+	cs.logAttack(e.Name, "Attack", p.Name, result, cs.attackText(e.Name, p.Name, result))
+	cs.hitPlayer(p, result)
 
 	// Check if player is defeated
 	if cs.IsPlayerDefeated(p) {
-		cs.ChangeCombatState(types.Dead)
-		if cs.combatUI != nil {
-			cs.combatUI.UpdateState(types.Dead)
-			cs.combatUI.AddAction("System", "Result", "", 0, "You have been defeated!")
-		}
+		cs.loseFight()
 	} else {
-		cs.ChangeCombatState(types.PlayerTurn)
-		if cs.combatUI != nil {
-			cs.combatUI.UpdateState(types.PlayerTurn)
-		}
+		cs.nextTurn()
 	}
 }
 
-// AiDefend makes the enemy take a defensive stance
+// AiDefend makes the enemy take a defensive stance until it is hit or its next turn
 func (cs *CombatSystem) AiDefend(e *entities.Enemy) {
-	// Enemy gains temporary defense boost
-	e.Defense += 2
+	e.Statuses.Add(types.StatusEffect{Kind: types.StatusDefending, Amount: config.DefendDefenseBonus, Turns: 1})
 
 	message := fmt.Sprintf("%s takes a defensive stance!", e.Name)
 	if cs.combatUI != nil {
 		cs.combatUI.AddAction(e.Name, "Defend", "", 0, message)
 	}
 
-	cs.ChangeCombatState(types.PlayerTurn)
-	if cs.combatUI != nil {
-		cs.combatUI.UpdateState(types.PlayerTurn)
-	}
+	cs.nextTurn()
 }
 
 // AiSpecialAttack performs a powerful but less accurate attack
 func (cs *CombatSystem) AiSpecialAttack(e *entities.Enemy, p *types.Player) {
-	// Check if special attack hits (70% accuracy)
-	if rand.Intn(100) >= 70 {
-		message := fmt.Sprintf("%s attempts a special attack but misses!", e.Name)
-		if cs.combatUI != nil {
-			cs.combatUI.AddAction(e.Name, "Special Attack", p.Name, 0, message)
-		}
-	} else {
-		// Special attack deals 1.5x damage
-		baseDamage := int(float64(e.Force) * 1.5)
-		damage := baseDamage - p.Stats.Defense
-		if damage < 1 {
-			damage = 1
-		}
+	// Special attacks deal 1.5x damage but miss more often
+	result := ResolveAttack(cs.rng, enemyRoll(e, e.Force*3/2, config.SpecialAttackAccuracyMalus), playerTarget(p))
 
-		// Apply damage
-		p.Stats.CurrentHP -= damage
-		if p.Stats.CurrentHP < 0 {
-			p.Stats.CurrentHP = 0
-		}
-
-		message := fmt.Sprintf("%s uses special attack on %s for %d damage!", e.Name, p.Name, damage)
-		if cs.combatUI != nil {
-			cs.combatUI.AddAction(e.Name, "Special Attack", p.Name, damage, message)
-		}
+	message := fmt.Sprintf("%s attempts a special attack but misses!", e.Name)
+	if result.Landed() {
+		message = fmt.Sprintf("%s uses special attack on %s for %d damage!", e.Name, p.Name, result.Damage)
 	}
+	cs.logAttack(e.Name, "Special Attack", p.Name, result, message)
+	cs.hitPlayer(p, result)
 
 	// Check if player is defeated
 	if cs.IsPlayerDefeated(p) {
-		cs.ChangeCombatState(types.Dead)
-		if cs.combatUI != nil {
-			cs.combatUI.UpdateState(types.Dead)
-			cs.combatUI.AddAction("System", "Result", "", 0, "You have been defeated!")
-		}
+		cs.loseFight()
 	} else {
-		cs.ChangeCombatState(types.PlayerTurn)
-		if cs.combatUI != nil {
-			cs.combatUI.UpdateState(types.PlayerTurn)
-		}
+		cs.nextTurn()
 	}
 }
 
 // AiHeal makes the enemy restore some health
 func (cs *CombatSystem) AiHeal(e *entities.Enemy) {
 	// Heal 15-25% of max HP
-	healAmount := int(float64(e.MaxHP) * (0.15 + rand.Float64()*0.1))
+	healAmount := int(float64(e.MaxHP) * (0.15 + cs.rng.Float64()*0.1))
 	if healAmount < 1 {
 		healAmount = 1
 	}
@@ -203,134 +181,164 @@ func (cs *CombatSystem) AiHeal(e *entities.Enemy) {
 		cs.combatUI.AddAction(e.Name, "Heal", "", healAmount, message)
 	}
 
-	cs.ChangeCombatState(types.PlayerTurn)
-	if cs.combatUI != nil {
-		cs.combatUI.UpdateState(types.PlayerTurn)
-	}
+	cs.nextTurn()
 }
 
-// AiTaunt makes the enemy taunt the player, reducing accuracy
+// AiTaunt makes the enemy taunt the player, reducing accuracy for a few turns
 func (cs *CombatSystem) AiTaunt(e *entities.Enemy, p *types.Player) {
-	// Temporarily reduce player accuracy
-	p.Stats.Accuracy -= 10
-	if p.Stats.Accuracy < 10 {
-		p.Stats.Accuracy = 10
-	}
+	p.Statuses.Add(types.StatusEffect{Kind: types.StatusTaunted, Amount: config.TauntAccuracyMalus, Turns: config.TauntTurns})
 
 	message := fmt.Sprintf("%s taunts %s, reducing accuracy!", e.Name, p.Name)
 	if cs.combatUI != nil {
 		cs.combatUI.AddAction(e.Name, "Taunt", p.Name, 0, message)
 	}
 
-	cs.ChangeCombatState(types.PlayerTurn)
-	if cs.combatUI != nil {
-		cs.combatUI.UpdateState(types.PlayerTurn)
-	}
+	cs.nextTurn()
 }
 
-// ProcessEnemyTurn handles the enemy's turn with random action selection
+// ProcessEnemyTurn plays the turn of the acting enemy with an action drawn from its AI profile
 func (cs *CombatSystem) ProcessEnemyTurn(p *types.Player) {
-	if cs.CurrentEnemy == nil {
+	enemy := cs.ActingEnemy()
+	if enemy == nil {
 		return
 	}
 
-	enemy := cs.CurrentEnemy
-
-	// Determine available actions based on enemy state and type
-	var availableActions []string
-
-	// Always available actions
-	availableActions = append(availableActions, "attack")
-
-	if enemy.Force > 40 {
-		// Stronger enemies can use special attacks
-		availableActions = append(availableActions, "special_attack")
-	}
-
-	// Always add defend as a possibility
-	if rand.Intn(100) < 20 {
-		availableActions = append(availableActions, "defend")
+	// Statuses act first, a stunned enemy or one downed by damage over time loses its turn
+	if cs.startTurn(enemyCombatant(enemy)) {
+		if !enemy.IsAlive && cs.defeatEnemy(enemy, p) {
+			return
+		}
+		cs.nextTurn()
+		return
 	}
 
-	// Select random action
-	selectedAction := availableActions[rand.Intn(len(availableActions))]
-
-	// Execute the selected action
-	switch selectedAction {
-	case "attack":
-		cs.AiAttack(*enemy, p)
-	case "defend":
+	switch cs.chooseEnemyAction(enemy) {
+	case types.ActionDefend:
 		cs.AiDefend(enemy)
-	case "special_attack":
+	case types.ActionSpecialAttack:
 		cs.AiSpecialAttack(enemy, p)
-	case "heal":
+	case types.ActionHeal:
 		cs.AiHeal(enemy)
-	case "taunt":
+	case types.ActionTaunt:
 		cs.AiTaunt(enemy, p)
 	default:
-		// Fallback to attack
 		cs.AiAttack(*enemy, p)
 	}
 }
 
 func (cs *CombatSystem) PlayerAttack(e *entities.Enemy, p *types.Player) {
-	damage := p.CalculateDamage(e.Defense)
-	message := fmt.Sprintf("%s attacks %s for %d damage!", p.Name, e.Name, damage)
+	result := ResolveAttack(cs.rng, playerRoll(p, 0, types.DamagePhysical, nil), enemyTarget(e))
+	cs.logAttack(p.Name, "Attack", e.Name, result, cs.attackText(p.Name, e.Name, result))
+	cs.landPlayerAttack(e, p, result)
+}
+
+// resolvePlayerHit applies the damage of a player attack, then ends the fight once every enemy is down or passes the turn
+func (cs *CombatSystem) resolvePlayerHit(e *entities.Enemy, p *types.Player, damage int) {
+	if e.TakeDamage(damage) {
+		if cs.defeatEnemy(e, p) {
+			return
+		}
+	} else if damage > 0 {
+		cs.statusHit(enemyCombatant(e))
+	}
+	cs.nextTurn()
+}
+
+// defeatEnemy logs a downed enemy and moves the target off it, reporting whether it was the last one standing
+func (cs *CombatSystem) defeatEnemy(e *entities.Enemy, p *types.Player) bool {
+	cs.logAction("System", "Defeated", cs.locManager.Text("ui.combat.defeated", e.Name))
+	cs.events.Publish(events.EnemyDefeated{Enemy: e})
+	next := cs.nextTarget()
+	if next == nil {
+		cs.winFight(p)
+		return true
+	}
+	if cs.CurrentEnemy == nil || !cs.CurrentEnemy.IsAlive {
+		cs.SetTarget(next)
+	}
+	return false
+}
+
+// loseFight ends the fight in defeat
+func (cs *CombatSystem) loseFight() {
+	cs.ChangeCombatState(types.Dead)
 	if cs.combatUI != nil {
-		cs.combatUI.AddAction(p.Name, "Attack", e.Name, damage, message)
+		cs.combatUI.UpdateState(types.Dead)
+		cs.combatUI.AddAction("System", "Result", "", 0, "You have been defeated!")
 	}
+}
 
-	defeated := e.TakeDamage(damage)
-	if defeated {
-		cs.ChangeCombatState(types.Victory)
-		if cs.combatUI != nil {
-			cs.combatUI.UpdateState(types.Victory)
-		}
+// winFight ends the fight in victory, the rewards of every enemy of the encounter are summed
+func (cs *CombatSystem) winFight(p *types.Player) {
+	cs.ChangeCombatState(types.Victory)
+	if cs.combatUI != nil {
+		cs.combatUI.UpdateState(types.Victory)
+	}
 
-		// Remove the defeated enemy from the game world immediately
-		if cs.spawnerSystem != nil {
-			cs.spawnerSystem.RemoveDefeatedEnemies()
-		}
+	// Remove the defeated enemies from the game world immediately
+	if cs.spawnerSystem != nil {
+		cs.spawnerSystem.RemoveDefeatedEnemies()
+	}
 
-		// Immediately refresh the game space to remove defeated enemies visually
-		if cs.onExitCallback != nil {
-			cs.onExitCallback()
+	exp, credits := 0, 0
+	for _, e := range cs.Enemies {
+		exp += e.ExpReward
+		credits += e.Credits
+	}
+	if p.AddExperience(exp) > 0 {
+		cs.events.Publish(events.PlayerLeveledUp{Player: p, Level: p.Stats.Level})
+	}
+	expMessage := fmt.Sprintf("%s gains %d experience!", p.Name, exp)
+	p.AddCredits(credits)
+	cs.dropLoot()
+	if cs.combatUI != nil {
+		cs.combatUI.AddAction("System", "Experience", "", 0, expMessage)
+		if credits > 0 {
+			creditMessage := fmt.Sprintf("%s picks up %d credits!", p.Name, credits)
+			cs.combatUI.AddAction("System", "Credits", "", 0, creditMessage)
 		}
+		cs.combatUI.AddAction("System", "Result", "", 0, "Victory!")
+	}
+}
 
-		p.AddExperience(e.ExpReward)
-		expMessage := fmt.Sprintf("%s gains %d experience!", p.Name, e.ExpReward)
-		if cs.combatUI != nil {
-			cs.combatUI.AddAction("System", "Experience", "", 0, expMessage)
-			cs.combatUI.AddAction("System", "Result", "", 0, "Victory!")
+// dropLoot rolls the loot table of every enemy of the encounter and leaves the drops where it fell
+func (cs *CombatSystem) dropLoot() {
+	if cs.lootSystem == nil {
+		return
+	}
+	for _, e := range cs.Enemies {
+		entry, ok := e.Loot.Draw(cs.rng)
+		if !ok {
+			continue
 		}
-	} else {
-		cs.ChangeCombatState(types.EnemyTurn)
-		if cs.combatUI != nil {
-			cs.combatUI.UpdateState(types.EnemyTurn)
+		item, ok := loaders.LootItem(entry)
+		if !ok {
+			continue
 		}
+		// Drops land in the middle of the enemy sprite
+		cs.lootSystem.Drop(item, types.Position{X: e.Position.X + 1, Y: e.Position.Y + 1})
+		cs.logAction("System", "Loot", cs.locManager.Text("ui.combat.loot", e.Name, cs.locManager.Text(item.Name), item.Count()))
 	}
 }
 
+// PlayerDefend raises the player's defense until they are hit or their next turn
 func (cs *CombatSystem) PlayerDefend(p *types.Player) {
-	// Defending reduces incoming damage for the next attack
+	p.Statuses.Add(types.StatusEffect{Kind: types.StatusDefending, Amount: config.DefendDefenseBonus, Turns: 1})
 	message := fmt.Sprintf("%s takes a defensive stance!", p.Name)
 	if cs.combatUI != nil {
 		cs.combatUI.AddAction(p.Name, "Defend", "", 0, message)
 	}
-	cs.ChangeCombatState(types.EnemyTurn)
-	if cs.combatUI != nil {
-		cs.combatUI.UpdateState(types.EnemyTurn)
-	}
+	cs.nextTurn()
 }
 
 func (cs *CombatSystem) PlayerRun(p *types.Player) bool {
 	// Simple run calculation - higher speed increases success chance
-	successChance := 50 + (p.Stats.Speed * 2) // Base 50% + 2% per speed point
+	successChance := 50 + (p.EffectiveStats().Speed * 2) // Base 50% + 2% per speed point
 	if successChance > 90 {
 		successChance = 90 // Cap at 90%
 	}
 
-	if rand.Intn(100) < successChance {
+	if cs.rng.Intn(100) < successChance {
 		message := fmt.Sprintf("%s successfully runs away!", p.Name)
 		if cs.combatUI != nil {
 			cs.combatUI.AddAction(p.Name, "Run", "", 0, message)
@@ -342,19 +350,11 @@ func (cs *CombatSystem) PlayerRun(p *types.Player) bool {
 		if cs.combatUI != nil {
 			cs.combatUI.AddAction(p.Name, "Run", "", 0, message)
 		}
-		cs.ChangeCombatState(types.EnemyTurn)
-		if cs.combatUI != nil {
-			cs.combatUI.UpdateState(types.EnemyTurn)
-		}
+		cs.nextTurn()
 		return false
 	}
 }
 
-func (cs *CombatSystem) UseConsumable(item types.Item, p *types.Player) string {
-	// Placeholder for using consumable items
-	return cs.locManager.Text(item.Name) + " used." // Example return message
-}
-
 // IsEnemyDefeated for checking if enemy is defeated
 func (cs *CombatSystem) IsEnemyDefeated(e entities.Enemy, p *types.Player) bool {
 	return e.CurrentHP <= 0
@@ -366,35 +366,44 @@ func (cs *CombatSystem) IsPlayerDefeated(player *types.Player) bool {
 }
 
 // CheckForCombatEngagement checks if the player is close enough to any enemy to start combat
-// Returns the enemy to engage with, or nil if no engagement
-func (cs *CombatSystem) CheckForCombatEngagement(player *types.Player) *entities.Enemy {
+// Returns every enemy within the aggro radius, or nil if no engagement
+func (cs *CombatSystem) CheckForCombatEngagement(player *types.Player) []*entities.Enemy {
 	if cs.IsInCombat() {
 		return nil // Already in combat
 	}
 
-	const combatRange = 3.0 // 2 character distance as specified
-	return cs.spawnerSystem.CheckPlayerProximity(player.Pos, combatRange)
+	if cs.spawnerSystem.CheckPlayerProximity(player.Pos, config.EngageRange) == nil {
+		return nil
+	}
+	return cs.spawnerSystem.EnemiesWithinRange(player.Pos, config.AggroRadius)
 }
 
 // TryEngageCombat attempts to engage combat if player is within range
 // Returns true if combat was initiated
 func (cs *CombatSystem) TryEngageCombat(player *types.Player) bool {
-	enemy := cs.CheckForCombatEngagement(player)
-	if enemy != nil {
-		cs.EnterCombat(enemy, player)
+	enemies := cs.CheckForCombatEngagement(player)
+	if len(enemies) > 0 {
+		cs.EnterCombat(enemies, player)
 		return true
 	}
 	return false
 }
 
-// GetCurrentEnemy returns the current enemy being fought
+// GetCurrentEnemy returns the enemy targeted by the player
 func (cs *CombatSystem) GetCurrentEnemy() *entities.Enemy {
 	return cs.CurrentEnemy
 }
 
 // ExitCombat ends the current combat encounter
 func (cs *CombatSystem) ExitCombat() {
+	victory := cs.CurrentCombatState == types.Victory
+	cs.clearStatuses()
 	cs.CurrentEnemy = nil
+	cs.Enemies = nil
+	cs.turnOrder = nil
+	cs.cooldowns = nil
+	cs.minds = nil
+	cs.player = nil
 	cs.ChangeCombatState(types.Idle)
 	if cs.combatUI != nil {
 		cs.combatUI.AddAction("System", "Combat", "", 0, "Combat ended.")
@@ -404,12 +413,7 @@ func (cs *CombatSystem) ExitCombat() {
 	if cs.spawnerSystem != nil {
 		cs.spawnerSystem.RemoveDefeatedEnemies()
 	}
-	// Immediately refresh the game world enemy list
-	if cs.onExitCallback != nil {
-		cs.onExitCallback()
-	}
-
-	// Trigger callback to signal end of combat (for state transition)
+	cs.events.Publish(events.CombatEnded{Victory: victory})
 }
 
 // GetCombatUI returns the combat UI instance
@@ -423,21 +427,21 @@ func (cs *CombatSystem) ProcessPlayerAction(action string, p *types.Player) bool
 		return false
 	}
 
+	if attackKey, ok := strings.CutPrefix(action, weaponActionPrefix); ok {
+		return cs.PlayerWeaponAttack(cs.CurrentEnemy, p, attackKey)
+	}
+	if implantKey, ok := strings.CutPrefix(action, implantActionPrefix); ok {
+		return cs.UseImplantAbility(cs.CurrentEnemy, p, implantKey)
+	}
+
 	switch action {
 	case "Attack":
 		cs.PlayerAttack(cs.CurrentEnemy, p)
 	case "Defend":
 		cs.PlayerDefend(p)
 	case "Use Item":
-		// TODO: Implement item usage
-		message := fmt.Sprintf("%s tries to use an item but has none!", p.Name)
-		if cs.combatUI != nil {
-			cs.combatUI.AddAction(p.Name, "Use Item", "", 0, message)
-		}
-		cs.ChangeCombatState(types.EnemyTurn)
-		if cs.combatUI != nil {
-			cs.combatUI.UpdateState(types.EnemyTurn)
-		}
+		// The turn is spent once an item is picked from the menu
+		cs.openItemMenu(p)
 	case "Run":
 		return cs.PlayerRun(p)
 	default:
@@ -452,12 +456,12 @@ func (cs *CombatSystem) IsReadyToExit() bool {
 	return cs.CurrentCombatState == types.Idle
 }
 
-// Update should be called each frame to handle AI turns and UI updates
-func (cs *CombatSystem) Update(p *types.Player) {
-	if cs.CurrentCombatState == types.EnemyTurn && cs.CurrentEnemy != nil {
+// Update should be called each frame with the elapsed time to handle AI turns and UI updates
+func (cs *CombatSystem) Update(p *types.Player, delta time.Duration) {
+	if cs.CurrentCombatState == types.EnemyTurn && cs.ActingEnemy() != nil {
 		// Countdown the delay before processing enemy turn
 		if cs.enemyTurnDelay > 0 {
-			cs.enemyTurnDelay--
+			cs.enemyTurnDelay -= delta
 		} else {
 			// Process the enemy turn
 			cs.ProcessEnemyTurn(p)
@@ -467,7 +471,7 @@ func (cs *CombatSystem) Update(p *types.Player) {
 	// Handle result display delay for victory/defeat states
 	if cs.CurrentCombatState == types.Victory || cs.CurrentCombatState == types.Dead {
 		if cs.resultDisplayDelay > 0 {
-			cs.resultDisplayDelay--
+			cs.resultDisplayDelay -= delta
 		} else {
 			// Auto-exit combat after showing result
 			cs.ExitCombat()
@@ -0,0 +1,79 @@
+package systems
+
+import (
+	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/types"
+)
+
+// enemyMind remembers what an enemy did during the fight, the conditions of its AI profile read it
+type enemyMind struct {
+	turns     int                       // Turns the enemy has played
+	cooldowns map[types.EnemyAction]int // Turns of the enemy left before each action can be picked again
+}
+
+// mind returns the memory of an enemy for the current fight
+func (cs *CombatSystem) mind(e *entities.Enemy) *enemyMind {
+	if cs.minds == nil {
+		cs.minds = make(map[*entities.Enemy]*enemyMind)
+	}
+	mind, ok := cs.minds[e]
+	if !ok {
+		mind = &enemyMind{cooldowns: make(map[types.EnemyAction]int)}
+		cs.minds[e] = mind
+	}
+	return mind
+}
+
+// chooseEnemyAction draws an action of the enemy's AI profile by weight among the ones whose conditions hold
+func (cs *CombatSystem) chooseEnemyAction(e *entities.Enemy) types.EnemyAction {
+	profile := e.AI
+	if len(profile.Actions) == 0 {
+		profile = types.DefaultAIProfile
+	}
+
+	mind := cs.mind(e)
+	for action, turns := range mind.cooldowns {
+		mind.cooldowns[action] = max(turns-1, 0)
+	}
+
+	var candidates []types.AIAction
+	total := 0
+	for _, action := range profile.Actions {
+		if action.Weight > 0 && canPick(e, mind, action) {
+			candidates = append(candidates, action)
+			total += action.Weight
+		}
+	}
+
+	chosen := types.AIAction{Action: types.ActionAttack}
+	if total > 0 {
+		roll := cs.rng.Intn(total)
+		for _, action := range candidates {
+			if roll < action.Weight {
+				chosen = action
+				break
+			}
+			roll -= action.Weight
+		}
+	}
+
+	mind.turns++
+	if chosen.Cooldown > 0 {
+		mind.cooldowns[chosen.Action] = chosen.Cooldown
+	}
+	return chosen.Action
+}
+
+// canPick reports whether the conditions of an AI action hold for the enemy
+func canPick(e *entities.Enemy, mind *enemyMind, action types.AIAction) bool {
+	if action.FirstTurn && mind.turns > 0 {
+		return false
+	}
+	if action.HPBelow > 0 && e.CurrentHP*100 >= action.HPBelow*e.MaxHP {
+		return false
+	}
+	if action.Action == types.ActionHeal && e.CurrentHP >= e.MaxHP {
+		return false
+	}
+	return mind.cooldowns[action.Action] == 0
+}
@@ -0,0 +1,85 @@
+package systems
+
+import (
+	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/types"
+	"projectred-rpg.com/ui"
+)
+
+// implantActionPrefix starts the action names of the implant abilities
+const implantActionPrefix = "Implant:"
+
+// implantActions lists the abilities of the installed implants with their cooldowns
+func (cs *CombatSystem) implantActions(p *types.Player) []ui.SkillAction {
+	var actions []ui.SkillAction
+	for _, implant := range p.Implants {
+		if !implant.Installed() || implant.Ability == nil {
+			continue
+		}
+		action := implantActionPrefix + implant.KeyName
+		actions = append(actions, ui.SkillAction{
+			Action:   action,
+			Name:     implant.Ability.Name,
+			Cooldown: cs.cooldowns[action],
+		})
+	}
+	return actions
+}
+
+// installedImplant returns the installed implant with the given key
+func installedImplant(p *types.Player, implantKey string) (types.Implant, bool) {
+	for _, implant := range p.Implants {
+		if implant.Installed() && implant.KeyName == implantKey {
+			return implant, true
+		}
+	}
+	return types.Implant{}, false
+}
+
+// UseImplantAbility triggers the ability of an installed implant, abilities still cooling down are refused
+func (cs *CombatSystem) UseImplantAbility(e *entities.Enemy, p *types.Player, implantKey string) bool {
+	implant, ok := installedImplant(p, implantKey)
+	if !ok || implant.Ability == nil {
+		return false
+	}
+
+	action := implantActionPrefix + implantKey
+	abilityName := cs.locManager.Text(implant.Ability.Name)
+	if turns := cs.cooldowns[action]; turns > 0 {
+		cs.logAction(p.Name, "Cooldown", cs.locManager.Text("ui.combat.not_ready", abilityName, turns))
+		return false
+	}
+
+	// The enemy turn right after the ability already counts one round down
+	if implant.Cooldown > 0 {
+		cs.cooldowns[action] = implant.Cooldown + 1
+	}
+
+	cs.logAction(p.Name, "Ability", cs.locManager.Text("ui.combat.ability", p.Name, abilityName))
+	damage := 0
+	for _, effect := range implant.Ability.Effects {
+		if effect.Kind == types.EffectDamage {
+			damage += effect.Amount
+			continue
+		}
+
+		cs.logAction(p.Name, "Ability", cs.applyEffect(effect, p))
+		// A flee effect already ended the fight
+		if cs.CurrentCombatState == types.Idle {
+			return true
+		}
+	}
+
+	if damage == 0 {
+		cs.resolvePlayerHit(e, p, 0)
+		cs.refreshSkillActions(p)
+		return true
+	}
+
+	// Damage effects strike like a weapon attack whose power is their sum
+	result := ResolveAttack(cs.rng, playerRoll(p, damage, implant.Ability.DamageType, nil), enemyTarget(e))
+	cs.logAttack(p.Name, "Ability", e.Name, result, cs.skillAttackText(p.Name, abilityName, e.Name, result))
+	cs.landPlayerAttack(e, p, result)
+	cs.refreshSkillActions(p)
+	return true
+}
@@ -0,0 +1,127 @@
+package systems
+
+import (
+	"strings"
+
+	"projectred-rpg.com/game/loaders"
+	"projectred-rpg.com/game/types"
+)
+
+// openItemMenu shows the player's consumables in the combat HUD, logging when there are none
+func (cs *CombatSystem) openItemMenu(p *types.Player) {
+	if cs.combatUI == nil {
+		return
+	}
+	if !cs.combatUI.OpenItemMenu() {
+		cs.logAction(p.Name, "Use Item", cs.locManager.Text("ui.combat.no_items", p.Name))
+	}
+}
+
+// UseItem uses one item of the inventory stack at index, then passes the turn
+func (cs *CombatSystem) UseItem(index int, p *types.Player) bool {
+	if cs.CurrentCombatState != types.PlayerTurn || cs.CurrentEnemy == nil {
+		return false
+	}
+	if index < 0 || index >= len(p.Inventory) || p.Inventory[index].Type != types.Consumable {
+		return false
+	}
+
+	item := p.Inventory[index]
+	p.ConsumeItem(index)
+	for _, message := range cs.UseConsumable(item, p) {
+		cs.logAction(p.Name, "Use Item", message)
+	}
+
+	// A flee effect already ended the fight
+	if cs.CurrentCombatState == types.Idle {
+		return true
+	}
+
+	cs.nextTurn()
+	return true
+}
+
+// UseConsumable applies the effects of a consumable to the fight and returns the lines describing them
+func (cs *CombatSystem) UseConsumable(item types.Item, p *types.Player) []string {
+	messages := []string{cs.locManager.Text("ui.combat.used", p.Name, cs.locManager.Text(item.Name))}
+
+	data, exists := loaders.GetConsumable(item.Key)
+	if !exists || len(data.Effects) == 0 {
+		return append(messages, cs.locManager.Text("ui.combat.no_effect"))
+	}
+
+	for _, effect := range data.Effects {
+		messages = append(messages, cs.applyEffect(effect, p))
+		if effect.Kind == types.EffectFlee {
+			break
+		}
+	}
+	return messages
+}
+
+// applyEffect applies a single item effect and returns its description
+func (cs *CombatSystem) applyEffect(effect types.ItemEffect, p *types.Player) string {
+	switch effect.Kind {
+	case types.EffectHeal:
+		return cs.healPlayer(p, effect.Amount)
+
+	case types.EffectHealPercent:
+		return cs.healPlayer(p, p.Stats.MaxHP*effect.Amount/100)
+
+	case types.EffectBuff:
+		if !types.IsStat(effect.Stat) {
+			break
+		}
+		p.Statuses.Add(types.StatusEffect{
+			Kind:   types.StatusBuff,
+			Stat:   effect.Stat,
+			Amount: effect.Amount,
+			Turns:  effect.Turns,
+		})
+		return cs.locManager.Text("ui.combat.buff", p.Name, effect.Amount, cs.statName(effect.Stat), max(effect.Turns, 1))
+
+	case types.EffectStun:
+		if cs.CurrentEnemy == nil {
+			break
+		}
+		turns := max(effect.Turns, 1)
+		cs.CurrentEnemy.Statuses.Add(types.StatusEffect{Kind: types.StatusStunned, Turns: turns})
+		return cs.locManager.Text("ui.combat.stunned", cs.CurrentEnemy.Name, turns)
+
+	case types.EffectBleed, types.EffectBurn:
+		if cs.CurrentEnemy == nil {
+			break
+		}
+		kind := types.StatusBleeding
+		if effect.Kind == types.EffectBurn {
+			kind = types.StatusBurning
+		}
+		return cs.addStatus(enemyCombatant(cs.CurrentEnemy), types.StatusEffect{Kind: kind, Amount: effect.Amount, Turns: effect.Turns})
+
+	case types.EffectFlee:
+		message := cs.locManager.Text("ui.combat.fled", p.Name)
+		cs.ExitCombat()
+		return message
+	}
+	return cs.locManager.Text("ui.combat.no_effect")
+}
+
+// healPlayer restores up to amount health points and describes the heal
+func (cs *CombatSystem) healPlayer(p *types.Player, amount int) string {
+	amount = min(amount, p.Stats.MaxHP-p.Stats.CurrentHP)
+	amount = max(amount, 0)
+	p.Stats.CurrentHP += amount
+	return cs.locManager.Text("ui.combat.heal", p.Name, amount, p.Name)
+}
+
+// statName returns the localized name of a stat
+func (cs *CombatSystem) statName(stat string) string {
+	return cs.locManager.Text("ui.class.menu." + strings.ToLower(stat))
+}
+
+// logAction adds a line to the combat history when the HUD exists
+func (cs *CombatSystem) logAction(actor, actionType, message string) {
+	if cs.combatUI != nil {
+		cs.combatUI.AddAction(actor, actionType, "", 0, message)
+	}
+}
@@ -0,0 +1,120 @@
+package systems
+
+import (
+	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/types"
+)
+
+// combatant is the player or an enemy of the fight, seen through the statuses it carries
+type combatant struct {
+	name     string
+	statuses *types.StatusList
+	player   *types.Player   // Set when the combatant is the player
+	enemy    *entities.Enemy // Set when the combatant is an enemy
+}
+
+func playerCombatant(p *types.Player) combatant {
+	return combatant{name: p.Name, statuses: &p.Statuses, player: p}
+}
+
+func enemyCombatant(e *entities.Enemy) combatant {
+	return combatant{name: e.Name, statuses: &e.Statuses, enemy: e}
+}
+
+// takeDamage removes health points from the combatant
+func (c combatant) takeDamage(amount int) {
+	if c.player != nil {
+		c.player.Stats.CurrentHP = max(c.player.Stats.CurrentHP-amount, 0)
+		return
+	}
+	c.enemy.TakeDamage(amount)
+}
+
+// down reports whether the combatant has no health left
+func (c combatant) down() bool {
+	if c.player != nil {
+		return c.player.Stats.CurrentHP <= 0
+	}
+	return !c.enemy.IsAlive
+}
+
+// statusHook reacts to the events of a fight for one kind of status
+type statusHook struct {
+	turnStart func(cs *CombatSystem, c combatant, status types.StatusEffect) bool // Reports whether the turn is lost
+	hit       func(cs *CombatSystem, c combatant, status types.StatusEffect)
+}
+
+var statusHooks = map[types.StatusKind]statusHook{
+	types.StatusDefending: {hit: breakGuard},
+	types.StatusBleeding:  {turnStart: damageOverTime},
+	types.StatusBurning:   {turnStart: damageOverTime},
+	types.StatusStunned:   {turnStart: loseTurn},
+}
+
+// damageOverTime deals the amount of a bleeding or burning status to its carrier
+func damageOverTime(cs *CombatSystem, c combatant, status types.StatusEffect) bool {
+	c.takeDamage(status.Amount)
+	cs.logAction(c.name, "Status", cs.locManager.Text("ui.combat.status_damage", c.name, status.Amount, cs.statusName(status.Kind)))
+	return false
+}
+
+// loseTurn makes a stunned combatant skip its turn
+func loseTurn(cs *CombatSystem, c combatant, status types.StatusEffect) bool {
+	cs.logAction(c.name, "Stunned", cs.locManager.Text("ui.combat.stun_skip", c.name))
+	return true
+}
+
+// breakGuard ends a defensive stance once it took a hit
+func breakGuard(cs *CombatSystem, c combatant, status types.StatusEffect) {
+	c.statuses.Remove(types.StatusDefending)
+	cs.logAction(c.name, "Status", cs.locManager.Text("ui.combat.guard_broken", c.name))
+}
+
+// addStatus applies a status to a combatant and describes it
+func (cs *CombatSystem) addStatus(c combatant, status types.StatusEffect) string {
+	c.statuses.Add(status)
+	return cs.locManager.Text("ui.combat.status_added", c.name, cs.statusName(status.Kind), max(status.Turns, 1))
+}
+
+// startTurn runs the turn start hooks of a combatant's statuses then counts them down, reporting whether the turn is lost
+func (cs *CombatSystem) startTurn(c combatant) bool {
+	lost := false
+	for _, status := range append(types.StatusList(nil), *c.statuses...) {
+		if hook := statusHooks[status.Kind].turnStart; hook != nil && hook(cs, c, status) {
+			lost = true
+		}
+	}
+
+	for _, status := range c.statuses.Tick() {
+		if status.Kind == types.StatusBuff {
+			cs.logAction(c.name, "Buff", cs.locManager.Text("ui.combat.buff_end", cs.statName(status.Stat), c.name))
+			continue
+		}
+		cs.logAction(c.name, "Status", cs.locManager.Text("ui.combat.status_end", c.name, cs.statusName(status.Kind)))
+	}
+	return lost || c.down()
+}
+
+// statusHit runs the hit hooks of a combatant's statuses after it took a landed hit
+func (cs *CombatSystem) statusHit(c combatant) {
+	for _, status := range append(types.StatusList(nil), *c.statuses...) {
+		if hook := statusHooks[status.Kind].hit; hook != nil {
+			hook(cs, c, status)
+		}
+	}
+}
+
+// clearStatuses removes the statuses of every combatant when the fight ends, base stats were never changed
+func (cs *CombatSystem) clearStatuses() {
+	if cs.player != nil {
+		cs.player.Statuses = nil
+	}
+	for _, e := range cs.Enemies {
+		e.Statuses = nil
+	}
+}
+
+// statusName returns the localized name of a status
+func (cs *CombatSystem) statusName(kind types.StatusKind) string {
+	return cs.locManager.Text("ui.combat.status." + string(kind))
+}
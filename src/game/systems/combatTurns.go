@@ -0,0 +1,120 @@
+package systems
+
+import (
+	"sort"
+
+	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/types"
+)
+
+// rollInitiative orders the player and the enemies of the fight by speed, the player goes first on ties
+func (cs *CombatSystem) rollInitiative(p *types.Player) {
+	cs.turnOrder = append([]*entities.Enemy{nil}, cs.Enemies...)
+	playerSpeed := p.EffectiveStats().Speed
+	speed := func(e *entities.Enemy) int {
+		if e == nil {
+			return playerSpeed
+		}
+		return e.Speed
+	}
+	sort.SliceStable(cs.turnOrder, func(i, j int) bool {
+		return speed(cs.turnOrder[i]) > speed(cs.turnOrder[j])
+	})
+	cs.turnIndex = 0
+}
+
+// ActingEnemy returns the enemy whose turn it is, nil on the player's turn
+func (cs *CombatSystem) ActingEnemy() *entities.Enemy {
+	if cs.turnIndex < 0 || cs.turnIndex >= len(cs.turnOrder) {
+		return nil
+	}
+	return cs.turnOrder[cs.turnIndex]
+}
+
+// beginTurn hands the turn to the combatant at the current initiative position
+func (cs *CombatSystem) beginTurn() {
+	state := types.PlayerTurn
+	if cs.ActingEnemy() != nil {
+		state = types.EnemyTurn
+	}
+
+	cs.ChangeCombatState(state)
+	if cs.combatUI != nil {
+		cs.combatUI.UpdateState(state)
+		cs.combatUI.SetTurnOrder(cs.turnOrder, cs.turnIndex)
+	}
+}
+
+// nextTurn moves to the next combatant still standing, cooldowns count down when a new round starts
+func (cs *CombatSystem) nextTurn() {
+	for range cs.turnOrder {
+		cs.turnIndex++
+		if cs.turnIndex >= len(cs.turnOrder) {
+			cs.turnIndex = 0
+			cs.newRound()
+		}
+		if e := cs.turnOrder[cs.turnIndex]; e == nil || e.IsAlive {
+			break
+		}
+	}
+	cs.beginTurn()
+
+	// The player's statuses act as their turn starts, enemies' ones when their delayed turn is played
+	if cs.ActingEnemy() == nil && cs.player != nil && cs.startTurn(playerCombatant(cs.player)) {
+		if cs.IsPlayerDefeated(cs.player) {
+			cs.loseFight()
+			return
+		}
+		cs.nextTurn()
+	}
+}
+
+// newRound counts down the player's skill cooldowns once every combatant has acted
+func (cs *CombatSystem) newRound() {
+	if cs.player == nil {
+		return
+	}
+	cs.tickCooldowns(cs.player)
+}
+
+// nextTarget returns the first enemy of the fight still standing
+func (cs *CombatSystem) nextTarget() *entities.Enemy {
+	for _, e := range cs.Enemies {
+		if e.IsAlive {
+			return e
+		}
+	}
+	return nil
+}
+
+// SetTarget makes the player's actions aim at an enemy of the fight
+func (cs *CombatSystem) SetTarget(e *entities.Enemy) {
+	cs.CurrentEnemy = e
+	if cs.combatUI != nil {
+		cs.combatUI.SetTarget(e)
+	}
+}
+
+// CycleTarget moves the player's target by delta among the enemies still standing
+func (cs *CombatSystem) CycleTarget(delta int) {
+	var alive []*entities.Enemy
+	current := 0
+	for _, e := range cs.Enemies {
+		if !e.IsAlive {
+			continue
+		}
+		if e == cs.CurrentEnemy {
+			current = len(alive)
+		}
+		alive = append(alive, e)
+	}
+	if len(alive) == 0 {
+		return
+	}
+
+	next := (current + delta) % len(alive)
+	if next < 0 {
+		next += len(alive)
+	}
+	cs.SetTarget(alive[next])
+}
@@ -0,0 +1,90 @@
+package systems
+
+import (
+	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/loaders"
+	"projectred-rpg.com/game/types"
+	"projectred-rpg.com/ui"
+)
+
+// weaponActionPrefix starts the action names of the equipped weapon attacks
+const weaponActionPrefix = "Weapon:"
+
+// equippedWeapon returns the data of the weapon the player holds
+func equippedWeapon(p *types.Player) (types.WeaponData, bool) {
+	if p.Weapon == nil {
+		return types.WeaponData{}, false
+	}
+	return loaders.GetWeapon(p.Weapon.Key)
+}
+
+// refreshSkillActions lists the attacks of the equipped weapon, the implant abilities and their cooldowns in the combat HUD
+func (cs *CombatSystem) refreshSkillActions(p *types.Player) {
+	if cs.combatUI == nil {
+		return
+	}
+
+	var actions []ui.SkillAction
+	if weapon, ok := equippedWeapon(p); ok {
+		for _, attack := range weapon.Attacks {
+			actions = append(actions, ui.SkillAction{
+				Action:   weaponActionPrefix + attack.KeyName,
+				Name:     attack.Name,
+				Cooldown: cs.cooldowns[attack.KeyName],
+			})
+		}
+	}
+	actions = append(actions, cs.implantActions(p)...)
+	cs.combatUI.SetSkillActions(actions)
+}
+
+// PlayerWeaponAttack strikes with an attack of the equipped weapon, attacks still cooling down are refused
+func (cs *CombatSystem) PlayerWeaponAttack(e *entities.Enemy, p *types.Player, attackKey string) bool {
+	weapon, ok := equippedWeapon(p)
+	if !ok {
+		return false
+	}
+	attack, ok := weapon.GetAttack(attackKey)
+	if !ok {
+		return false
+	}
+	if turns := cs.cooldowns[attackKey]; turns > 0 {
+		cs.logAction(p.Name, "Cooldown", cs.locManager.Text("ui.combat.not_ready", cs.locManager.Text(attack.Name), turns))
+		return false
+	}
+
+	// The enemy turn right after the attack already counts one round down
+	if attack.CoolDown > 0 {
+		cs.cooldowns[attackKey] = attack.CoolDown + 1
+	}
+
+	result := ResolveAttack(cs.rng, playerRoll(p, attack.Damage, attack.DamageType, attack.Effects), enemyTarget(e))
+	cs.logAttack(p.Name, "Attack", e.Name, result, cs.skillAttackText(p.Name, cs.locManager.Text(attack.Name), e.Name, result))
+	cs.landPlayerAttack(e, p, result)
+	cs.refreshSkillActions(p)
+	return true
+}
+
+// skillAttackText describes a resolved weapon attack or implant ability, attackName is already localized
+func (cs *CombatSystem) skillAttackText(user, attackName, target string, result types.AttackResult) string {
+	switch result.Outcome {
+	case types.AttackMiss:
+		return cs.locManager.Text("ui.combat.weapon_miss", user, attackName, target)
+	case types.AttackCritical:
+		return cs.locManager.Text("ui.combat.weapon_critical", user, attackName, target, result.Damage)
+	default:
+		return cs.locManager.Text("ui.combat.weapon_attack", user, attackName, target, result.Damage)
+	}
+}
+
+// tickCooldowns counts weapon and implant cooldowns down at the end of a round
+func (cs *CombatSystem) tickCooldowns(p *types.Player) {
+	for key, turns := range cs.cooldowns {
+		if turns <= 1 {
+			delete(cs.cooldowns, key)
+		} else {
+			cs.cooldowns[key] = turns - 1
+		}
+	}
+	cs.refreshSkillActions(p)
+}
@@ -1,7 +1,11 @@
 package systems
 
 import (
+	"sort"
+	"strconv"
+
 	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/events"
 	"projectred-rpg.com/game/types"
 	"projectred-rpg.com/ui"
 )
@@ -19,48 +23,71 @@ type DialogSequence struct {
 	Current int
 }
 
-// DialogSystem manages dialog interactions and UI
+// DialogueContext gives dialogue graphs access to the game, conditions read it and actions change it
+type DialogueContext interface {
+	Player() *types.Player
+	QuestState(id string) types.QuestState
+	GiveItem(key string, quantity int)
+	StartCombat(enemy string)
+	OpenShop(shop string)
+	StartQuest(id string)
+}
+
+// DialogSystem walks dialogue graphs and manages the dialog UI
 type DialogSystem struct {
-	dialogBox     *ui.DialogBox
-	isActive      bool
-	currentDialog *DialogSequence
-	locManager    *engine.LocalizationManager
-	onComplete    func() // Callback when dialog sequence completes
+	dialogBox  *ui.DialogBox
+	isActive   bool
+	graph      types.DialogueGraph
+	node       string                 // Node being shown
+	choices    []types.DialogueChoice // Choices of the shown node whose conditions hold
+	context    DialogueContext        // Nil for plain sequences, conditions on the game then fail
+	flags      map[string]bool        // Flags set by dialogue actions
+	npcPos     types.Position
+	locManager *engine.LocalizationManager
+	events     *events.Bus // Where the end of dialogues is published
 }
 
-// NewDialogSystem creates a new dialog system
-func NewDialogSystem(maxWidth int) *DialogSystem {
+// NewDialogSystem creates a new dialog system publishing DialogueEnded on bus
+func NewDialogSystem(maxWidth int, bus *events.Bus) *DialogSystem {
 	return &DialogSystem{
 		dialogBox:  ui.NewDialogBox(maxWidth),
 		isActive:   false,
+		flags:      make(map[string]bool),
 		locManager: engine.GetLocalizationManager(),
+		events:     bus,
 	}
 }
 
 // StartDialog begins a new dialog sequence with the specified NPC
-func (ds *DialogSystem) StartDialog(sequence *DialogSequence, npcPos types.Position, onComplete func()) {
+func (ds *DialogSystem) StartDialog(sequence *DialogSequence, npcPos types.Position) {
 	if sequence == nil || len(sequence.Entries) == 0 {
 		return
 	}
 
-	ds.currentDialog = sequence
-	ds.currentDialog.Current = 0
+	sequence.Current = 0
+	ds.StartGraph(sequence.Graph(), nil, npcPos)
+}
+
+// StartGraph begins a dialogue graph at its start node, context may be nil when no node needs the game
+func (ds *DialogSystem) StartGraph(graph types.DialogueGraph, context DialogueContext, npcPos types.Position) {
+	ds.graph = graph
+	ds.context = context
+	ds.npcPos = npcPos
 	ds.isActive = true
-	ds.onComplete = onComplete
 
-	// Display the first dialog entry
-	ds.showCurrentEntry(npcPos)
+	ds.enter(graph.Start)
 }
 
-// EndDialog ends the current dialog sequence
+// EndDialog ends the current dialog sequence, publishing DialogueEnded when one was active
 func (ds *DialogSystem) EndDialog() {
+	wasActive := ds.isActive
 	ds.isActive = false
-	ds.currentDialog = nil
+	ds.node = ""
+	ds.choices = nil
 	ds.dialogBox.Hide()
 
-	if ds.onComplete != nil {
-		ds.onComplete()
-		ds.onComplete = nil
+	if wasActive {
+		ds.events.Publish(events.DialogueEnded{Dialogue: ds.graph.KeyName})
 	}
 }
 
@@ -69,28 +96,52 @@ func (ds *DialogSystem) IsActive() bool {
 	return ds.isActive
 }
 
+// Flags returns the flags set by dialogue actions, sorted so save files stay stable
+func (ds *DialogSystem) Flags() []string {
+	flags := make([]string, 0, len(ds.flags))
+	for flag := range ds.flags {
+		flags = append(flags, flag)
+	}
+	sort.Strings(flags)
+	return flags
+}
+
+// RestoreFlags replaces the dialogue flags, used for a new or loaded game
+func (ds *DialogSystem) RestoreFlags(flags []string) {
+	ds.flags = make(map[string]bool, len(flags))
+	for _, flag := range flags {
+		ds.flags[flag] = true
+	}
+}
+
 // Update processes messages and updates the dialog system
 func (ds *DialogSystem) Update(msg engine.Msg) {
 	if !ds.isActive {
 		return
 	}
 
-	// Update the dialog box
+	// Enter first completes the text, the next one moves on
+	complete := ds.dialogBox.IsTextComplete()
 	ds.dialogBox, _ = ds.dialogBox.Update(msg)
 
 	// Handle dialog progression
 	if keyMsg, ok := msg.(engine.KeyMsg); ok {
 		switch keyMsg.Rune {
 		case '\r', ' ': // Enter or Space
-			if ds.dialogBox.IsTextComplete() {
-				ds.nextEntry()
+			if complete {
+				ds.advance()
 			}
-		case 27: // Escape key
+		case engine.RuneEscape:
 			ds.EndDialog()
 		}
 	}
 }
 
+// IsTyping reports whether the shown text is still being typed
+func (ds *DialogSystem) IsTyping() bool {
+	return ds.isActive && !ds.dialogBox.IsTextComplete()
+}
+
 // Render returns the rendered dialog box
 func (ds *DialogSystem) Render() string {
 	if !ds.isActive {
@@ -104,43 +155,162 @@ func (ds *DialogSystem) GetDialogBox() *ui.DialogBox {
 	return ds.dialogBox
 }
 
-// showCurrentEntry displays the current dialog entry
-func (ds *DialogSystem) showCurrentEntry(npcPos types.Position) {
-	if ds.currentDialog == nil || ds.currentDialog.Current >= len(ds.currentDialog.Entries) {
-		ds.EndDialog()
+// enter shows a node, skipping the nodes without text, and ends the dialogue on an empty or unknown ID
+func (ds *DialogSystem) enter(id string) {
+	// Routing nodes can only be crossed once each, more hops means the branches loop
+	for hops := 0; hops <= len(ds.graph.Nodes); hops++ {
+		node, exists := ds.graph.Nodes[id]
+		if !exists {
+			break
+		}
+
+		ds.node = id
+		if ds.runActions(node.Actions) {
+			return
+		}
+		if node.Text != "" {
+			ds.show(node)
+			return
+		}
+		id = ds.follow(node)
+	}
+	ds.EndDialog()
+}
+
+// show displays a node with the choices the player can pick
+func (ds *DialogSystem) show(node types.DialogueNode) {
+	speakerText := ""
+	if speaker := node.Speaker; speaker != "" || ds.graph.Speaker != "" {
+		if speaker == "" {
+			speaker = ds.graph.Speaker
+		}
+		speakerText = ds.locManager.Text(speaker)
+	}
+
+	ds.choices = nil
+	var choiceTexts []string
+	for _, choice := range node.Choices {
+		if ds.holds(choice.Conditions) {
+			ds.choices = append(ds.choices, choice)
+			choiceTexts = append(choiceTexts, ds.locManager.Text(choice.Text))
+		}
+	}
+
+	args := node.Args
+	if args == nil && ds.context != nil {
+		args = []any{ds.context.Player().Name}
+	}
+	ds.dialogBox.Show(ds.locManager.Text(node.Text, args...), speakerText, ds.npcPos.X, ds.npcPos.Y)
+	ds.dialogBox.SetChoices(choiceTexts)
+}
+
+// advance leaves the shown node through the selected choice, or its branches when it has none
+func (ds *DialogSystem) advance() {
+	if len(ds.choices) == 0 {
+		ds.enter(ds.follow(ds.graph.Nodes[ds.node]))
 		return
 	}
 
-	entry := ds.currentDialog.Entries[ds.currentDialog.Current]
+	choice := ds.choices[ds.dialogBox.SelectedChoice()]
+	if ds.runActions(choice.Actions) {
+		return
+	}
+	ds.enter(choice.Next)
+}
 
-	// Get localized text
-	speakerText := ""
-	if entry.SpeakerKey != "" {
-		speakerText = ds.locManager.Text(entry.SpeakerKey)
+// follow returns the node reached by the first branch that holds, or the node's Next
+func (ds *DialogSystem) follow(node types.DialogueNode) string {
+	for _, branch := range node.Branches {
+		if ds.holds(branch.Conditions) {
+			return branch.Next
+		}
 	}
+	return node.Next
+}
 
-	dialogText := ds.locManager.Text(entry.TextKey, entry.Args...)
+// holds reports whether every condition holds
+func (ds *DialogSystem) holds(conditions []types.DialogueCondition) bool {
+	for _, condition := range conditions {
+		if !ds.check(condition) {
+			return false
+		}
+	}
+	return true
+}
 
-	// Show the dialog box
-	ds.dialogBox.Show(dialogText, speakerText, npcPos.X, npcPos.Y)
+// check reports whether a single condition holds
+func (ds *DialogSystem) check(condition types.DialogueCondition) bool {
+	var holds bool
+	switch condition.Kind {
+	case types.ConditionFlag:
+		holds = ds.flags[condition.Flag]
+	case types.ConditionHasItem:
+		holds = ds.context != nil && ds.context.Player().CountItem(condition.Item) >= max(condition.Quantity, 1)
+	case types.ConditionLevel:
+		holds = ds.context != nil && ds.context.Player().Stats.Level >= condition.Level
+	case types.ConditionQuest:
+		state := types.QuestNotStarted
+		if ds.context != nil {
+			state = ds.context.QuestState(condition.Quest)
+		}
+		holds = state == condition.State
+	}
+	return holds != condition.Not
 }
 
-// nextEntry advances to the next dialog entry or ends the dialog
-func (ds *DialogSystem) nextEntry() {
-    if ds.currentDialog == nil {
-        return
-    }
+// runActions runs actions in order and reports whether one of them ended the dialogue
+func (ds *DialogSystem) runActions(actions []types.DialogueAction) bool {
+	for _, action := range actions {
+		if action.Kind == types.ActionSetFlag {
+			if action.Clear {
+				delete(ds.flags, action.Flag)
+			} else {
+				ds.flags[action.Flag] = true
+			}
+			continue
+		}
+		if ds.context == nil {
+			continue
+		}
 
-    ds.currentDialog.Current++
-    
-    if ds.currentDialog.Current >= len(ds.currentDialog.Entries) {
-        // End of dialog sequence
-        ds.EndDialog()
-    } else {
-        // Show next entry - use a default position since GetPosition doesn't exist
-        defaultPos := types.Position{X: 2, Y: 5} // Position par défaut
-        ds.showCurrentEntry(defaultPos)
-    }
+		if action.Kind.Ends() {
+			ds.EndDialog()
+		}
+		switch action.Kind {
+		case types.ActionGiveItem:
+			ds.context.GiveItem(action.Item, max(action.Quantity, 1))
+		case types.ActionStartQuest:
+			ds.context.StartQuest(action.Quest)
+		case types.ActionStartCombat:
+			ds.context.StartCombat(action.Enemy)
+			return true
+		case types.ActionOpenShop:
+			ds.context.OpenShop(action.Shop)
+			return true
+		}
+	}
+	return false
+}
+
+// Graph returns the sequence as a dialogue graph whose nodes follow each other
+func (ds *DialogSequence) Graph() types.DialogueGraph {
+	graph := types.DialogueGraph{
+		Start: "0",
+		Nodes: make(map[string]types.DialogueNode, len(ds.Entries)),
+	}
+	for i, entry := range ds.Entries {
+		next := ""
+		if i < len(ds.Entries)-1 {
+			next = strconv.Itoa(i + 1)
+		}
+		graph.Nodes[strconv.Itoa(i)] = types.DialogueNode{
+			Speaker: entry.SpeakerKey,
+			Text:    entry.TextKey,
+			Args:    entry.Args,
+			Next:    next,
+		}
+	}
+	return graph
 }
 
 // CreateSimpleDialog creates a simple dialog sequence with a single entry
@@ -0,0 +1,149 @@
+package systems
+
+import (
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/types"
+)
+
+// EnemyAISystem moves the stage enemies in exploration: they stand at their post or patrol, and chase the player once they see them
+type EnemyAISystem struct {
+	spawner   *SpawnerSystem
+	movement  *MovementSystem
+	steps     int // Steps played, patrolling enemies only move on some of them
+	restSteps int // Steps left during which enemies ignore the player
+}
+
+// NewEnemyAISystem creates an AI system moving the enemies of the spawner with the collision rules of movement
+func NewEnemyAISystem(spawner *SpawnerSystem, movement *MovementSystem) *EnemyAISystem {
+	return &EnemyAISystem{spawner: spawner, movement: movement}
+}
+
+// Rest makes every enemy give up the chase and ignore the player for a while, used when a fight ends
+func (ai *EnemyAISystem) Rest() {
+	ai.restSteps = config.EnemyRestSteps
+	for _, enemy := range ai.spawner.GetActiveEnemies() {
+		enemy.Alert = types.AlertIdle
+	}
+}
+
+// Step moves every enemy by at most one tile and reports whether one came within engage range of the player
+func (ai *EnemyAISystem) Step(player *types.Player, tm *types.TileMap) bool {
+	if player == nil {
+		return false
+	}
+	ai.steps++
+	if ai.restSteps > 0 {
+		ai.restSteps--
+	}
+
+	engaged := false
+	for _, enemy := range ai.spawner.GetActiveEnemies() {
+		ai.updateAlert(enemy, player, tm)
+		if target, ok := ai.target(enemy, player); ok {
+			ai.moveToward(enemy, target, tm)
+		}
+		if ai.restSteps == 0 && enemy.DistanceTo(player.Pos) <= config.EngageRange {
+			engaged = true
+		}
+	}
+	return engaged
+}
+
+// updateAlert starts a chase when the enemy sees the player within sight and ends it once they are far enough
+func (ai *EnemyAISystem) updateAlert(enemy *entities.Enemy, player *types.Player, tm *types.TileMap) {
+	sight := float64(enemySight(enemy))
+	distance := enemy.DistanceTo(player.Pos)
+
+	switch {
+	case ai.restSteps == 0 && distance <= sight && ai.canSee(enemy.Position, player.Pos, tm):
+		enemy.Alert = types.AlertChase
+	case enemy.Alert == types.AlertChase && distance <= sight*config.ChaseLeashPercent/100:
+		// Keeps chasing until the player leaves the leash
+	case len(enemy.Patrol) > 0:
+		enemy.Alert = types.AlertPatrol
+	default:
+		enemy.Alert = types.AlertIdle
+	}
+}
+
+// target returns where the enemy walks to this step, false when it stays put
+func (ai *EnemyAISystem) target(enemy *entities.Enemy, player *types.Player) (types.Position, bool) {
+	if enemy.Alert == types.AlertChase {
+		return player.Pos, true
+	}
+	if ai.steps%config.PatrolStepEvery != 0 {
+		return types.Position{}, false
+	}
+
+	if enemy.Alert == types.AlertPatrol {
+		waypoint := enemy.Patrol[enemy.Waypoint%len(enemy.Patrol)]
+		if enemy.Position == waypoint {
+			enemy.Waypoint = (enemy.Waypoint + 1) % len(enemy.Patrol)
+			waypoint = enemy.Patrol[enemy.Waypoint]
+		}
+		return waypoint, true
+	}
+	return enemy.Home, enemy.Position != enemy.Home
+}
+
+// moveToward takes the first step of the shortest path to target, going around the sprites of the other enemies.
+// Enemies already stacked on each other may still move, so they can part.
+func (ai *EnemyAISystem) moveToward(enemy *entities.Enemy, target types.Position, tm *types.TileMap) {
+	w, h := ai.movement.spriteFootprintTiles(nil)
+	var others []types.Position
+	for _, other := range ai.spawner.GetActiveEnemies() {
+		if other != enemy && !footprintsOverlap(enemy.Position, other.Position, w, h) {
+			others = append(others, other.Position)
+		}
+	}
+
+	free := func(pos types.Position) bool {
+		for _, other := range others {
+			if footprintsOverlap(pos, other, w, h) {
+				return false
+			}
+		}
+		return true
+	}
+	walkable := func(pos types.Position) bool {
+		return free(pos) && ai.movement.isWalkableRect(tm, pos.X, pos.Y, w, h)
+	}
+
+	// The target itself is always reachable for the search, so the step is checked too
+	path := FindPath(enemy.Position, target, walkable, config.PathSearchLimit)
+	if len(path) == 0 || !free(path[0]) {
+		return
+	}
+	enemy.SetPosition(path[0])
+}
+
+// footprintsOverlap reports whether two sprites of w by h tiles placed at a and b cover a common tile
+func footprintsOverlap(a, b types.Position, w, h int) bool {
+	return abs(a.X-b.X) < w && abs(a.Y-b.Y) < h
+}
+
+// canSee reports whether no tile blocking sight stands between two sprites, looking from the center of their footprints
+func (ai *EnemyAISystem) canSee(from, to types.Position, tm *types.TileMap) bool {
+	if tm == nil {
+		return true
+	}
+	w, h := ai.movement.spriteFootprintTiles(nil)
+	blocks := func(pos types.Position) bool {
+		_, props := ai.movement.TileInfo(tm, pos.X, pos.Y)
+		return props.BlocksSight
+	}
+	return LineOfSight(
+		types.Position{X: from.X + w/2, Y: from.Y + h/2},
+		types.Position{X: to.X + w/2, Y: to.Y + h/2},
+		blocks,
+	)
+}
+
+// enemySight returns the distance at which the enemy notices the player
+func enemySight(enemy *entities.Enemy) int {
+	if enemy.Sight > 0 {
+		return enemy.Sight
+	}
+	return config.DefaultEnemySight
+}
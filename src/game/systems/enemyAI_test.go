@@ -0,0 +1,49 @@
+package systems
+
+import (
+	"testing"
+
+	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/types"
+)
+
+func TestChasingEnemiesDoNotStack(t *testing.T) {
+	ss := NewSpawnerSystem()
+	for _, pos := range []types.Position{{X: 10, Y: 10}, {X: 16, Y: 10}, {X: 13, Y: 14}} {
+		ss.ActiveEnemies = append(ss.ActiveEnemies, &entities.Enemy{Position: pos, Sight: 100, IsAlive: true})
+	}
+	ai := NewEnemyAISystem(ss, NewMovementSystem())
+	w, h := ai.movement.spriteFootprintTiles(nil)
+	player := &types.Player{Pos: types.Position{X: 40, Y: 30}}
+
+	for step := range 60 {
+		ai.Step(player, nil)
+		enemies := ss.GetActiveEnemies()
+		for i, a := range enemies {
+			for _, b := range enemies[i+1:] {
+				if footprintsOverlap(a.Position, b.Position, w, h) {
+					t.Fatalf("step %d: enemies at %v and %v overlap", step, a.Position, b.Position)
+				}
+			}
+		}
+	}
+	for _, enemy := range ss.GetActiveEnemies() {
+		if enemy.Alert != types.AlertChase {
+			t.Errorf("enemy at %v is not chasing", enemy.Position)
+		}
+	}
+}
+
+func TestStackedEnemiesCanPart(t *testing.T) {
+	ss := NewSpawnerSystem()
+	ss.ActiveEnemies = []*entities.Enemy{
+		{Position: types.Position{X: 10, Y: 10}, Sight: 100, IsAlive: true},
+		{Position: types.Position{X: 11, Y: 10}, Sight: 100, IsAlive: true},
+	}
+	ai := NewEnemyAISystem(ss, NewMovementSystem())
+
+	ai.Step(&types.Player{Pos: types.Position{X: 10, Y: 30}}, nil)
+	if ss.ActiveEnemies[0].Position == (types.Position{X: 10, Y: 10}) && ss.ActiveEnemies[1].Position == (types.Position{X: 11, Y: 10}) {
+		t.Error("stacked enemies stayed stuck on each other")
+	}
+}
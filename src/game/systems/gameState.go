@@ -16,6 +16,8 @@ const (
 	StatePauseMenu
 	StateStageTransition
 	StateDebugMenu
+	StateSaveSlots
+	StateQuestLog
 )
 
 type GameState struct {
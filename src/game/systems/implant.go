@@ -0,0 +1,47 @@
+package systems
+
+import (
+	"errors"
+
+	"projectred-rpg.com/game/types"
+)
+
+// ErrNoImplant is returned when removing an implant from an empty body slot
+var ErrNoImplant = errors.New("no implant installed")
+
+// ImplantSystem installs and removes the implants of the player's body slots
+type ImplantSystem struct{}
+
+// NewImplantSystem creates a new implant system instance
+func NewImplantSystem() *ImplantSystem {
+	return &ImplantSystem{}
+}
+
+// Install puts an implant in its body slot, returning the implant it replaced if there was one
+func (is *ImplantSystem) Install(player *types.Player, implant types.Implant) (types.Implant, bool) {
+	previous := player.Implants[implant.Type]
+	player.Implants[implant.Type] = implant
+	return previous, previous.Installed()
+}
+
+// Remove empties a body slot and returns the implant it held
+func (is *ImplantSystem) Remove(player *types.Player, slot types.BodyParts) (types.Implant, error) {
+	if slot < types.Head || slot > types.Legs || !player.Implants[slot].Installed() {
+		return types.Implant{}, ErrNoImplant
+	}
+
+	implant := player.Implants[slot]
+	player.Implants[slot] = types.Implant{}
+	return implant, nil
+}
+
+// Installed returns the installed implants in body slot order
+func (is *ImplantSystem) Installed(player *types.Player) []types.Implant {
+	var implants []types.Implant
+	for _, implant := range player.Implants {
+		if implant.Installed() {
+			implants = append(implants, implant)
+		}
+	}
+	return implants
+}
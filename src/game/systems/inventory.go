@@ -1,22 +1,52 @@
 package systems
 
 import (
+	"errors"
+	"sort"
+
+	"projectred-rpg.com/game/events"
+	"projectred-rpg.com/game/loaders"
 	"projectred-rpg.com/game/types"
 )
 
+// ErrNotUsable is returned when using an item that does nothing outside of a fight
+var ErrNotUsable = errors.New("item cannot be used outside of a fight")
+
+// ErrFullHealth is returned when using a healing item at full health
+var ErrFullHealth = errors.New("health is already full")
+
+// ErrNotEquippable is returned when equipping an item that is not a weapon
+var ErrNotEquippable = errors.New("item cannot be equipped")
+
+// ErrNoItem is returned when an inventory index holds no item
+var ErrNoItem = errors.New("no item at this index")
+
+// InventoryOrder tells how Sort orders the inventory
+type InventoryOrder int
+
+const (
+	OrderByType  InventoryOrder = iota // Grouped by item type, then by name
+	OrderByName                        // Alphabetical
+	OrderByValue                       // Most valuable first
+)
+
 // InventorySystem handles inventory management operations
 type InventorySystem struct {
-	// Inventory configuration and state
+	events *events.Bus // Where the items entering the inventory are published
 }
 
-// NewInventorySystem creates a new inventory system instance
-func NewInventorySystem() *InventorySystem {
-	return &InventorySystem{}
+// NewInventorySystem creates a new inventory system instance publishing ItemAcquired on bus
+func NewInventorySystem(bus *events.Bus) *InventorySystem {
+	return &InventorySystem{events: bus}
 }
 
 // AddItem attempts to add an item to the player's inventory
 func (is *InventorySystem) AddItem(player *types.Player, item types.Item) bool {
-	return player.AddItemToInventory(item)
+	if !player.AddItemToInventory(item) {
+		return false
+	}
+	is.events.Publish(events.ItemAcquired{Player: player, Item: item})
+	return true
 }
 
 // RemoveItem attempts to remove an item from the player's inventory by index
@@ -47,4 +77,122 @@ func (is *InventorySystem) FindItemByName(player *types.Player, name string) (ty
 		}
 	}
 	return types.Item{}, -1, false
-}
\ No newline at end of file
+}
+
+// EquipNextWeapon equips the first weapon of the inventory.
+// The previous weapon goes to the end of the inventory, so repeated calls cycle through the weapons.
+func (is *InventorySystem) EquipNextWeapon(player *types.Player) bool {
+	for i, item := range player.Inventory {
+		if item.Type != types.Weapon {
+			continue
+		}
+		hadWeapon := player.Weapon != nil
+		if !player.EquipWeapon(i) {
+			return false
+		}
+		if hadWeapon {
+			previous := player.Inventory[i]
+			player.RemoveItemFromInventory(i)
+			player.Inventory = append(player.Inventory, previous)
+		}
+		return true
+	}
+	return false
+}
+
+// UseItem uses one item of the consumable stack at index outside of a fight and returns the health restored.
+// Only healing effects work outside of a fight, the stack is left untouched when none applies.
+func (is *InventorySystem) UseItem(player *types.Player, index int) (int, error) {
+	if index < 0 || index >= len(player.Inventory) {
+		return 0, ErrNoItem
+	}
+	item := player.Inventory[index]
+	if item.Type != types.Consumable {
+		return 0, ErrNotUsable
+	}
+	data, exists := loaders.GetConsumable(item.Key)
+	if !exists {
+		return 0, ErrNotUsable
+	}
+
+	heal, heals := 0, false
+	for _, effect := range data.Effects {
+		switch effect.Kind {
+		case types.EffectHeal:
+			heal += effect.Amount
+			heals = true
+		case types.EffectHealPercent:
+			heal += player.Stats.MaxHP * effect.Amount / 100
+			heals = true
+		}
+	}
+	if !heals {
+		return 0, ErrNotUsable
+	}
+
+	missing := player.Stats.MaxHP - player.Stats.CurrentHP
+	if missing <= 0 {
+		return 0, ErrFullHealth
+	}
+	heal = min(heal, missing)
+	player.Stats.CurrentHP += heal
+	player.ConsumeItem(index)
+	return heal, nil
+}
+
+// Equip equips the weapon at index, the previous weapon takes its inventory slot
+func (is *InventorySystem) Equip(player *types.Player, index int) error {
+	if index < 0 || index >= len(player.Inventory) {
+		return ErrNoItem
+	}
+	if !player.EquipWeapon(index) {
+		return ErrNotEquippable
+	}
+	return nil
+}
+
+// Drop removes the whole stack at index from the inventory and returns it
+func (is *InventorySystem) Drop(player *types.Player, index int) (types.Item, error) {
+	if index < 0 || index >= len(player.Inventory) {
+		return types.Item{}, ErrNoItem
+	}
+	item := player.Inventory[index]
+	player.RemoveItemFromInventory(index)
+	return item, nil
+}
+
+// Stack merges the stacks of identical stackable items, freeing their slots
+func (is *InventorySystem) Stack(player *types.Player) {
+	merged := make([]types.Item, 0, len(player.Inventory))
+	stacks := make(map[string]int) // Position of the first stack of each key in merged
+	for _, item := range player.Inventory {
+		if item.Stackable() {
+			if i, ok := stacks[item.Key]; ok {
+				merged[i].Quantity = merged[i].Count() + item.Count()
+				continue
+			}
+			stacks[item.Key] = len(merged)
+		}
+		merged = append(merged, item)
+	}
+	player.Inventory = merged
+}
+
+// Sort stacks identical items then orders the inventory, name returns the displayed name of an item
+func (is *InventorySystem) Sort(player *types.Player, order InventoryOrder, name func(types.Item) string) {
+	is.Stack(player)
+	sort.SliceStable(player.Inventory, func(i, j int) bool {
+		a, b := player.Inventory[i], player.Inventory[j]
+		switch order {
+		case OrderByType:
+			if a.Type != b.Type {
+				return a.Type < b.Type
+			}
+		case OrderByValue:
+			if a.Value != b.Value {
+				return a.Value > b.Value
+			}
+		}
+		return name(a) < name(b)
+	})
+}
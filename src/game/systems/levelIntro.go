@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/events"
 	"projectred-rpg.com/ui"
 )
 
@@ -18,7 +19,7 @@ type LevelIntroSystem struct {
 	localization       map[string]interface{}
 	language           string
 	isActive           bool
-	onComplete         func()
+	events             *events.Bus // Where the end of intros is published
 	currentDialogIndex int
 	currentDialogs     []DialogLine
 }
@@ -29,12 +30,13 @@ type DialogLine struct {
 	Order   int
 }
 
-// NewLevelIntroSystem creates a new level intro system
-func NewLevelIntroSystem(language string) *LevelIntroSystem {
+// NewLevelIntroSystem creates a new level intro system publishing IntroEnded on bus
+func NewLevelIntroSystem(language string, bus *events.Bus) *LevelIntroSystem {
 	return &LevelIntroSystem{
 		dialogBox:      ui.NewDialogBox(100), // Ajoutez la largeur par défaut
 		language:       language,
 		isActive:       false,
+		events:         bus,
 		currentDialogs: make([]DialogLine, 0),
 	}
 }
@@ -55,8 +57,8 @@ func (lis *LevelIntroSystem) LoadLocalization() error {
 	return nil
 }
 
-// ShowIntro displays the introduction for a specific level
-func (lis *LevelIntroSystem) ShowIntro(levelFilename string, screenWidth, screenHeight int, onComplete func()) bool {
+// ShowIntro displays the introduction for a specific level, IntroEnded is published once the player went through it
+func (lis *LevelIntroSystem) ShowIntro(levelFilename string, screenWidth, screenHeight int) bool {
 	// Extract world and stage from filename (e.g., "world-1_stage-1.map" -> world1, stage1)
 	levelName := strings.TrimSuffix(levelFilename, ".map")
 	parts := strings.Split(levelName, "_")
@@ -109,7 +111,6 @@ func (lis *LevelIntroSystem) ShowIntro(levelFilename string, screenWidth, screen
 	}
 
 
-	lis.onComplete = onComplete
 	lis.isActive = true
 	lis.currentDialogIndex = 0
 
@@ -183,9 +184,7 @@ func (lis *LevelIntroSystem) Update(msg engine.Msg) (*LevelIntroSystem, engine.C
 				if lis.currentDialogIndex >= len(lis.currentDialogs) {
 					// All dialogs shown, complete intro
 					lis.Hide()
-					if lis.onComplete != nil {
-						lis.onComplete()
-					}
+					lis.events.Publish(events.IntroEnded{})
 				} else {
 					// Show next dialog
 					nextDialog := lis.currentDialogs[lis.currentDialogIndex]
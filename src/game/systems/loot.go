@@ -0,0 +1,155 @@
+package systems
+
+import (
+	"fmt"
+	"sort"
+
+	"projectred-rpg.com/game/loaders"
+	"projectred-rpg.com/game/types"
+)
+
+// LootSystem keeps the items lying on the ground of each stage and the chests the player opened
+type LootSystem struct {
+	ground map[string][]types.GroundItem // Items on the ground, by stage key
+	opened map[string]bool               // Opened chests, by stage key and chest ID
+	stage  string                        // Key of the loaded stage
+	chests []types.Chest                 // Chests of the loaded stage
+}
+
+// NewLootSystem creates a loot system with nothing on the ground and every chest closed
+func NewLootSystem() *LootSystem {
+	ls := &LootSystem{}
+	ls.Reset(nil)
+	return ls
+}
+
+// Reset forgets the items on the ground and marks only the given chests as opened, used for a new or loaded game
+func (ls *LootSystem) Reset(openedChests []string) {
+	ls.ground = make(map[string][]types.GroundItem)
+	ls.opened = make(map[string]bool, len(openedChests))
+	for _, key := range openedChests {
+		ls.opened[key] = true
+	}
+	ls.stage = ""
+	ls.chests = nil
+}
+
+// stageKey identifies a stage in the ground items and the opened chests
+func stageKey(worldID, stageNb int) string {
+	return fmt.Sprintf("%d-%d", worldID, stageNb)
+}
+
+// chestKey identifies a chest of the loaded stage in the opened chests
+func (ls *LootSystem) chestKey(id string) string {
+	return ls.stage + "/" + id
+}
+
+// LoadStage makes the chests of a stage current, the ones opened earlier stay open
+func (ls *LootSystem) LoadStage(worldID, stageNb int, chests []types.Chest) {
+	ls.stage = stageKey(worldID, stageNb)
+	ls.chests = make([]types.Chest, len(chests))
+	for i, chest := range chests {
+		chest.Opened = ls.opened[ls.chestKey(chest.ID)]
+		ls.chests[i] = chest
+	}
+}
+
+// Drop leaves an item on the ground of the loaded stage
+func (ls *LootSystem) Drop(item types.Item, pos types.Position) {
+	ls.ground[ls.stage] = append(ls.ground[ls.stage], types.GroundItem{Item: item, Pos: pos})
+}
+
+// GroundItems returns the items on the ground of the loaded stage
+func (ls *LootSystem) GroundItems() []types.GroundItem {
+	return ls.ground[ls.stage]
+}
+
+// Chests returns the chests of the loaded stage
+func (ls *LootSystem) Chests() []types.Chest {
+	return ls.chests
+}
+
+// OpenedChests returns the key of every opened chest, sorted so save files stay stable
+func (ls *LootSystem) OpenedChests() []string {
+	keys := make([]string, 0, len(ls.opened))
+	for key := range ls.opened {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// inReach reports whether pos is at most reach tiles away from the player sprite on both axes
+func inReach(player *types.Player, pos types.Position, reach int) bool {
+	w, h := 4, 3 // Player footprint, see MovementSystem.spriteFootprintTiles
+	dx := max(player.Pos.X-pos.X, pos.X-(player.Pos.X+w-1), 0)
+	dy := max(player.Pos.Y-pos.Y, pos.Y-(player.Pos.Y+h-1), 0)
+	return dx <= reach && dy <= reach
+}
+
+// ItemsInReach returns the items on the ground the player can pick up
+func (ls *LootSystem) ItemsInReach(player *types.Player, reach int) []types.GroundItem {
+	var items []types.GroundItem
+	for _, ground := range ls.GroundItems() {
+		if inReach(player, ground.Pos, reach) {
+			items = append(items, ground)
+		}
+	}
+	return items
+}
+
+// ChestInReach returns the closed chest the player can open, or nil
+func (ls *LootSystem) ChestInReach(player *types.Player, reach int) *types.Chest {
+	for i := range ls.chests {
+		center := types.Position{X: ls.chests[i].Pos.X + 1, Y: ls.chests[i].Pos.Y}
+		if !ls.chests[i].Opened && inReach(player, center, reach) {
+			return &ls.chests[i]
+		}
+	}
+	return nil
+}
+
+// PickUp moves the items on the ground within reach into the inventory.
+// Items that do not fit stay on the ground, full reports whether one was left behind.
+func (ls *LootSystem) PickUp(player *types.Player, inventory *InventorySystem, reach int) (picked []types.Item, full bool) {
+	var remaining []types.GroundItem
+	for _, ground := range ls.GroundItems() {
+		if !inReach(player, ground.Pos, reach) {
+			remaining = append(remaining, ground)
+			continue
+		}
+		if !inventory.AddItem(player, ground.Item) {
+			remaining = append(remaining, ground)
+			full = true
+			continue
+		}
+		picked = append(picked, ground.Item)
+	}
+	ls.ground[ls.stage] = remaining
+	return picked, full
+}
+
+// OpenChest opens the closed chest within reach and gives its credits and items.
+// Items that do not fit in the inventory are dropped at the foot of the chest.
+func (ls *LootSystem) OpenChest(player *types.Player, inventory *InventorySystem, reach int) (chest *types.Chest, items []types.Item, ok bool) {
+	chest = ls.ChestInReach(player, reach)
+	if chest == nil {
+		return nil, nil, false
+	}
+
+	chest.Opened = true
+	ls.opened[ls.chestKey(chest.ID)] = true
+	player.AddCredits(chest.Credits)
+
+	for _, entry := range chest.Contents {
+		item, exists := loaders.LootItem(entry)
+		if !exists {
+			continue
+		}
+		items = append(items, item)
+		if !inventory.AddItem(player, item) {
+			ls.Drop(item, types.Position{X: chest.Pos.X, Y: chest.Pos.Y + 1})
+		}
+	}
+	return chest, items, true
+}
@@ -0,0 +1,160 @@
+package systems
+
+import (
+	"errors"
+
+	"projectred-rpg.com/config"
+	"projectred-rpg.com/game/types"
+)
+
+// ErrNotEnoughCredits is returned when the player cannot pay for an item
+var ErrNotEnoughCredits = errors.New("not enough credits")
+
+// ErrInventoryFull is returned when a bought item has no free slot
+var ErrInventoryFull = errors.New("inventory full")
+
+// ErrOutOfStock is returned when a shop has sold every copy of an item in the current world
+var ErrOutOfStock = errors.New("out of stock")
+
+// ErrNotForSale is returned when trading an entry that is not an item, such as a section header
+var ErrNotForSale = errors.New("item is not for sale")
+
+// MerchantSystem handles trades between the player and merchants
+type MerchantSystem struct {
+	stocks    map[string]*shopStock // Limited stock left, by catalog key
+	inventory *InventorySystem      // Where bought items are added
+}
+
+// shopStock is what a shop has left to sell in the world it was last restocked in
+type shopStock struct {
+	worldID int
+	left    map[stockKey]int
+}
+
+// stockKey locates an entry in a catalog
+type stockKey struct {
+	section int
+	entry   int
+}
+
+// NewMerchantSystem creates a new merchant system instance adding bought items through inventory
+func NewMerchantSystem(inventory *InventorySystem) *MerchantSystem {
+	return &MerchantSystem{stocks: make(map[string]*shopStock), inventory: inventory}
+}
+
+// Restock refills the limited stock of a shop the first time it is visited in a world
+func (ms *MerchantSystem) Restock(catalog types.MerchantCatalog, worldID int) {
+	if stock, ok := ms.stocks[catalog.KeyName]; ok && stock.worldID == worldID {
+		return
+	}
+
+	stock := &shopStock{worldID: worldID, left: make(map[stockKey]int)}
+	for i, section := range catalog.Sections {
+		for j, entry := range section.Items {
+			if entry.Stock > 0 {
+				stock.left[stockKey{section: i, entry: j}] = entry.Stock
+			}
+		}
+	}
+	ms.stocks[catalog.KeyName] = stock
+}
+
+// StockLeft returns the copies a shop has left of a catalog entry, -1 when the stock is unlimited
+func (ms *MerchantSystem) StockLeft(shop string, section, entry int) int {
+	stock, ok := ms.stocks[shop]
+	if !ok {
+		return -1
+	}
+	left, limited := stock.left[stockKey{section: section, entry: entry}]
+	if !limited {
+		return -1
+	}
+	return left
+}
+
+// BuyFromShop buys a catalog entry of a shop, taking one copy from its limited stock
+func (ms *MerchantSystem) BuyFromShop(player *types.Player, shop string, section, entry int, item types.Item, price int) error {
+	left := ms.StockLeft(shop, section, entry)
+	if left == 0 {
+		return ErrOutOfStock
+	}
+	if err := ms.Buy(player, item, price); err != nil {
+		return err
+	}
+	if left > 0 {
+		ms.stocks[shop].left[stockKey{section: section, entry: entry}] = left - 1
+	}
+	return nil
+}
+
+// Buy charges price credits and adds the item to the inventory, nothing changes when it fails
+func (ms *MerchantSystem) Buy(player *types.Player, item types.Item, price int) error {
+	if price <= 0 {
+		return ErrNotForSale
+	}
+	if player.Credits < price {
+		return ErrNotEnoughCredits
+	}
+
+	item.Value = price
+	if !ms.inventory.AddItem(player, item) {
+		return ErrInventoryFull
+	}
+	player.SpendCredits(price)
+	return nil
+}
+
+// SellPrice returns the credits a merchant pays for one item
+func (ms *MerchantSystem) SellPrice(item types.Item) int {
+	return item.Value * config.SellBackPercent / 100
+}
+
+// Sell removes one item of the inventory slot at index and credits its sell price
+func (ms *MerchantSystem) Sell(player *types.Player, index int) (int, error) {
+	if index < 0 || index >= len(player.Inventory) {
+		return 0, ErrNotForSale
+	}
+
+	price := ms.SellPrice(player.Inventory[index])
+	if price <= 0 {
+		return 0, ErrNotForSale
+	}
+	player.ConsumeItem(index)
+	player.AddCredits(price)
+	return price, nil
+}
+
+// InstallFromShop buys an implant from a clinic and installs it, the implant it replaces is bought back
+func (ms *MerchantSystem) InstallFromShop(player *types.Player, implants *ImplantSystem, shop string, section, entry int, implant types.Implant, price int) error {
+	left := ms.StockLeft(shop, section, entry)
+	if left == 0 {
+		return ErrOutOfStock
+	}
+	if price <= 0 {
+		return ErrNotForSale
+	}
+	if !player.SpendCredits(price) {
+		return ErrNotEnoughCredits
+	}
+
+	implant.Price = price
+	if previous, replaced := implants.Install(player, implant); replaced {
+		player.AddCredits(ms.SellPrice(previous.Item()))
+	}
+	if left > 0 {
+		ms.stocks[shop].left[stockKey{section: section, entry: entry}] = left - 1
+	}
+	return nil
+}
+
+// RemoveImplant takes out the implant of a body slot and credits its sell price
+func (ms *MerchantSystem) RemoveImplant(player *types.Player, implants *ImplantSystem, slot types.BodyParts) (int, error) {
+	implant, err := implants.Remove(player, slot)
+	if err != nil {
+		return 0, err
+	}
+
+	price := ms.SellPrice(implant.Item())
+	player.AddCredits(price)
+	return price, nil
+}
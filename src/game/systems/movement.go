@@ -9,11 +9,50 @@ import (
 type MovementSystem struct {
 	// Movement configuration
 	currentMap *types.TileMap
+	slowSteps  int                     // Move inputs already spent trying to leave a slowing tile
+	lastHazard *StepHazard             // Damage taken on the last step, until collected by TakeHazard
+	blocked    map[types.Position]bool // Tiles taken by NPCs, which neither the player nor enemies walk through
+}
+
+// StepHazard describes the damage dealt by the tiles the player stepped on
+type StepHazard struct {
+	Tile        rune
+	Damage      int
+	Description string // Localization key of the damaging tile
 }
 
 // ResetMap resets the movement system's internal map reference.
 func (ms *MovementSystem) ResetMap(tm *types.TileMap) {
 	ms.currentMap = tm
+	ms.slowSteps = 0
+	ms.lastHazard = nil
+	ms.blocked = nil
+}
+
+// SetBlocked replaces the tiles nobody can walk through besides the map walls, such as the ones NPCs stand on
+func (ms *MovementSystem) SetBlocked(tiles []types.Position) {
+	ms.blocked = make(map[types.Position]bool, len(tiles))
+	for _, tile := range tiles {
+		ms.blocked[tile] = true
+	}
+}
+
+// TileInfo returns the glyph at 1-based coordinates (x,y) and its properties.
+// Glyphs missing from the world legend are walkable unless config lists them as walls.
+func (ms *MovementSystem) TileInfo(tm *types.TileMap, x, y int) (rune, types.TileProps) {
+	ch, props, ok := tm.TileAt(x-1, y-1)
+	if !ok {
+		wall := config.IsMapWall(ch)
+		props = types.TileProps{Walkable: !wall, BlocksSight: wall}
+	}
+	return ch, props
+}
+
+// TakeHazard returns the damage taken on the last step and clears it
+func (ms *MovementSystem) TakeHazard() *StepHazard {
+	hazard := ms.lastHazard
+	ms.lastHazard = nil
+	return hazard
 }
 
 // NewMovementSystem creates a new movement system instance
@@ -38,6 +77,9 @@ func (ms *MovementSystem) isWalkable(tm *types.TileMap, x, y int) bool {
 	if x > tm.Width || y > tm.Height {
 		return false
 	}
+	if ms.blocked[types.Position{X: x, Y: y}] {
+		return false
+	}
 
 	// Check if this is an outer wall position
 	mapX, mapY := x-1, y-1
@@ -46,8 +88,8 @@ func (ms *MovementSystem) isWalkable(tm *types.TileMap, x, y int) bool {
 		return true
 	}
 
-	ch := tm.At(mapX, mapY)
-	return !config.IsMapWall(ch)
+	_, props := ms.TileInfo(tm, x, y)
+	return props.Walkable
 }
 
 // isWalkableRect checks a rectangle region of size (w x h) at top-left (x,y) for collisions and bounds.
@@ -170,6 +212,13 @@ func (ms *MovementSystem) MovePlayer(player *types.Player, direction rune, tm *t
 	targetX := oldX + dx
 	targetY := oldY + dy
 
+	// Slowing tiles swallow some move inputs before letting the player leave, fast players shrug some off
+	slow := ms.footprintSlow(tm, oldX, oldY, wTiles, hTiles) - player.EffectiveStats().Speed/config.SpeedPerSlowStep
+	if ms.slowSteps < slow {
+		ms.slowSteps++
+		return false
+	}
+
 	if tm != nil {
 		mapW, mapH := tm.Width, tm.Height
 
@@ -216,7 +265,54 @@ func (ms *MovementSystem) MovePlayer(player *types.Player, direction rune, tm *t
 
 	player.Pos.X = targetX
 	player.Pos.Y = targetY
-	return player.Pos.X != oldX || player.Pos.Y != oldY
+	moved := player.Pos.X != oldX || player.Pos.Y != oldY
+	if moved {
+		ms.slowSteps = 0
+		ms.applyHazard(player, tm, wTiles, hTiles)
+	}
+	return moved
+}
+
+// footprintSlow returns the strongest slow of the tiles under the footprint at (x,y)
+func (ms *MovementSystem) footprintSlow(tm *types.TileMap, x, y, w, h int) int {
+	if tm == nil {
+		return 0
+	}
+	slow := 0
+	for yy := y; yy < y+h; yy++ {
+		for xx := x; xx < x+w; xx++ {
+			if _, props := ms.TileInfo(tm, xx, yy); props.Slow > slow {
+				slow = props.Slow
+			}
+		}
+	}
+	return slow
+}
+
+// applyHazard deals the damage of the most harmful tile under the player's footprint
+func (ms *MovementSystem) applyHazard(player *types.Player, tm *types.TileMap, w, h int) {
+	if tm == nil {
+		return
+	}
+
+	var worst *StepHazard
+	for yy := player.Pos.Y; yy < player.Pos.Y+h; yy++ {
+		for xx := player.Pos.X; xx < player.Pos.X+w; xx++ {
+			ch, props := ms.TileInfo(tm, xx, yy)
+			if props.Damage > 0 && (worst == nil || props.Damage > worst.Damage) {
+				worst = &StepHazard{Tile: ch, Damage: props.Damage, Description: props.Description}
+			}
+		}
+	}
+	if worst == nil {
+		return
+	}
+
+	player.Stats.CurrentHP -= worst.Damage
+	if player.Stats.CurrentHP < 0 {
+		player.Stats.CurrentHP = 0
+	}
+	ms.lastHazard = worst
 }
 
 // ValidatePosition checks if a position is within the game bounds
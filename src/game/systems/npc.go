@@ -1,6 +1,7 @@
 package systems
 
 import (
+	"projectred-rpg.com/game/events"
 	"projectred-rpg.com/game/types"
 )
 
@@ -11,12 +12,16 @@ type NPCSystem struct {
 	interaction *types.NPC // Currently interacting NPC
 }
 
-// NewNPCSystem creates a new NPC management system
-func NewNPCSystem(dialogSystem *DialogSystem) *NPCSystem {
-	return &NPCSystem{
+// NewNPCSystem creates a new NPC management system, interactions end with the dialogues published on bus
+func NewNPCSystem(dialogSystem *DialogSystem, bus *events.Bus) *NPCSystem {
+	ns := &NPCSystem{
 		npcs:      make(map[string]*types.NPC),
 		dialogSys: dialogSystem,
 	}
+	bus.OnDialogueEnded(func(events.DialogueEnded) {
+		ns.EndInteraction()
+	})
+	return ns
 }
 
 // AddNPC adds an NPC to the system
@@ -34,6 +39,12 @@ func (ns *NPCSystem) GetNPC(id string) *types.NPC {
 	return ns.npcs[id]
 }
 
+// Clear removes every NPC, such as when a new stage is loaded
+func (ns *NPCSystem) Clear() {
+	ns.npcs = make(map[string]*types.NPC)
+	ns.interaction = nil
+}
+
 // GetAllNPCs returns all NPCs
 func (ns *NPCSystem) GetAllNPCs() map[string]*types.NPC {
 	return ns.npcs
@@ -61,9 +72,31 @@ func (ns *NPCSystem) StartInteraction(npc *types.NPC, playerName string) {
 	dialogKey := npc.GetDialogKey() + ".greeting"
 	dialog := CreateSimpleDialog("", dialogKey, playerName)
 	
-	ns.dialogSys.StartDialog(dialog, npc.Pos, func() {
-		ns.EndInteraction()
-	})
+	ns.dialogSys.StartDialog(dialog, npc.Pos)
+}
+
+// TalkTo starts an interaction with the NPC.
+// Merchants and implant clinics with a shop return its catalog key instead of greeting the player.
+func (ns *NPCSystem) TalkTo(npc *types.NPC, playerName string) string {
+	if npc == nil || !npc.IsActive {
+		return ""
+	}
+	if (npc.Type == types.NPCMerchant || npc.Type == types.NPCClinic) && npc.Shop != "" {
+		ns.interaction = npc
+		return npc.Shop
+	}
+	ns.StartInteraction(npc, playerName)
+	return ""
+}
+
+// StartDialogue walks a dialogue graph with the NPC, its conditions and actions go through context
+func (ns *NPCSystem) StartDialogue(npc *types.NPC, graph types.DialogueGraph, context DialogueContext) {
+	if npc == nil || !npc.IsActive {
+		return
+	}
+
+	ns.interaction = npc
+	ns.dialogSys.StartGraph(graph, context, npc.Pos)
 }
 
 // StartCustomDialog starts a custom dialog sequence with an NPC
@@ -73,9 +106,7 @@ func (ns *NPCSystem) StartCustomDialog(npc *types.NPC, dialog *DialogSequence) {
 	}
 
 	ns.interaction = npc
-	ns.dialogSys.StartDialog(dialog, npc.Pos, func() {
-		ns.EndInteraction()
-	})
+	ns.dialogSys.StartDialog(dialog, npc.Pos)
 }
 
 // EndInteraction ends the current NPC interaction
@@ -143,18 +174,21 @@ func (ns *NPCSystem) StartQuestDialog(npc *types.NPC, playerName string) {
 	ns.StartCustomDialog(npc, dialog)
 }
 
-// CreateNPCSprite creates a simple sprite for an NPC (placeholder)
+// CreateNPCSprite returns the sprite drawn for an NPC type without its own sprite.
+// Sprites are three tiles wide and tall, one rune per tile.
 func CreateNPCSprite(npcType types.NPCType) string {
 	switch npcType {
 	case types.NPCGuard:
-		return "🛡️\n👤\n🦵"
+		return " ▲ \n[█]\n/ \\"
 	case types.NPCMerchant:
-		return "💰\n👤\n🦵"
+		return " $ \n/█\\\n/ \\"
 	case types.NPCVillager:
-		return "👒\n👤\n🦵"
+		return " o \n/█\\\n/ \\"
 	case types.NPCAethelgard:
-		return "🎩\n👤\n🦵"
+		return "▄█▄\n/█\\\n/ \\"
+	case types.NPCClinic:
+		return " + \n/█\\\n/ \\"
 	default:
-		return "?\n👤\n🦵"
+		return " ? \n/█\\\n/ \\"
 	}
-}
\ No newline at end of file
+}
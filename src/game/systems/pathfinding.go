@@ -0,0 +1,133 @@
+package systems
+
+import (
+	"container/heap"
+
+	"projectred-rpg.com/game/types"
+)
+
+// pathNode is a position waiting to be explored by FindPath
+type pathNode struct {
+	pos      types.Position
+	cost     int // Steps from the start
+	estimate int // Cost plus the distance left to the goal
+	index    int // Position in the open set heap
+}
+
+// openSet orders the nodes to explore by estimate, it implements heap.Interface
+type openSet []*pathNode
+
+func (set openSet) Len() int { return len(set) }
+func (set openSet) Less(i, j int) bool {
+	return set[i].estimate < set[j].estimate
+}
+func (set openSet) Swap(i, j int) {
+	set[i], set[j] = set[j], set[i]
+	set[i].index = i
+	set[j].index = j
+}
+func (set *openSet) Push(x any) {
+	node := x.(*pathNode)
+	node.index = len(*set)
+	*set = append(*set, node)
+}
+func (set *openSet) Pop() any {
+	old := *set
+	node := old[len(old)-1]
+	*set = old[:len(old)-1]
+	return node
+}
+
+// manhattan returns the number of straight steps between two positions
+func manhattan(a, b types.Position) int {
+	return abs(a.X-b.X) + abs(a.Y-b.Y)
+}
+
+// FindPath returns the steps from start to goal with A*, moving one tile up, down, left or right at a time.
+// The start is excluded and the goal included, nil when the goal cannot be reached within limit explored tiles.
+func FindPath(start, goal types.Position, walkable func(pos types.Position) bool, limit int) []types.Position {
+	if start == goal {
+		return nil
+	}
+
+	open := &openSet{}
+	nodes := map[types.Position]*pathNode{start: {pos: start, estimate: manhattan(start, goal)}}
+	cameFrom := make(map[types.Position]types.Position)
+	closed := make(map[types.Position]bool)
+	heap.Push(open, nodes[start])
+
+	directions := []types.Position{{X: 0, Y: -1}, {X: 0, Y: 1}, {X: -1, Y: 0}, {X: 1, Y: 0}}
+	for open.Len() > 0 && len(closed) < limit {
+		current := heap.Pop(open).(*pathNode)
+		if current.pos == goal {
+			return buildPath(cameFrom, start, goal)
+		}
+		closed[current.pos] = true
+
+		for _, dir := range directions {
+			next := types.Position{X: current.pos.X + dir.X, Y: current.pos.Y + dir.Y}
+			if closed[next] || (next != goal && !walkable(next)) {
+				continue
+			}
+
+			cost := current.cost + 1
+			node, seen := nodes[next]
+			if seen && cost >= node.cost {
+				continue
+			}
+
+			cameFrom[next] = current.pos
+			if !seen {
+				node = &pathNode{pos: next}
+				nodes[next] = node
+				node.cost, node.estimate = cost, cost+manhattan(next, goal)
+				heap.Push(open, node)
+				continue
+			}
+			node.cost, node.estimate = cost, cost+manhattan(next, goal)
+			heap.Fix(open, node.index)
+		}
+	}
+	return nil
+}
+
+// buildPath walks the explored links back from goal to start
+func buildPath(cameFrom map[types.Position]types.Position, start, goal types.Position) []types.Position {
+	var path []types.Position
+	for pos := goal; pos != start; pos = cameFrom[pos] {
+		path = append(path, pos)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// LineOfSight reports whether no tile strictly between from and to blocks sight, along a Bresenham line
+func LineOfSight(from, to types.Position, blocks func(types.Position) bool) bool {
+	dx, dy := abs(to.X-from.X), -abs(to.Y-from.Y)
+	stepX, stepY := 1, 1
+	if from.X > to.X {
+		stepX = -1
+	}
+	if from.Y > to.Y {
+		stepY = -1
+	}
+
+	pos, err := from, dx+dy
+	for pos != to {
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			pos.X += stepX
+		}
+		if e2 <= dx {
+			err += dx
+			pos.Y += stepY
+		}
+		if pos != to && blocks(pos) {
+			return false
+		}
+	}
+	return true
+}
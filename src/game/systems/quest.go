@@ -0,0 +1,155 @@
+package systems
+
+import (
+	"projectred-rpg.com/game/loaders"
+	"projectred-rpg.com/game/types"
+)
+
+// QuestSystem tracks the progress of the player in every quest they started
+type QuestSystem struct {
+	progress map[string]*types.QuestProgress // Started quests, by key
+}
+
+// NewQuestSystem creates a quest system where only the quests started with every game are active
+func NewQuestSystem() *QuestSystem {
+	qs := &QuestSystem{}
+	qs.Reset(nil)
+	return qs
+}
+
+// Reset replaces the progress with a saved one, used for a new or loaded game.
+// Quests started with every game and missing from saved are started.
+func (qs *QuestSystem) Reset(saved map[string]types.QuestProgress) {
+	qs.progress = make(map[string]*types.QuestProgress, len(saved))
+	for key, progress := range saved {
+		quest, exists := loaders.GetQuest(key)
+		if !exists {
+			continue
+		}
+		// Quests edited since the save keep the progress of the objectives they still have
+		counts := make([]int, len(quest.Objectives))
+		copy(counts, progress.Progress)
+		qs.progress[key] = &types.QuestProgress{State: progress.State, Progress: counts}
+	}
+	for _, quest := range loaders.GetQuests() {
+		if quest.AutoStart {
+			qs.Start(quest.KeyName)
+		}
+	}
+}
+
+// Progress returns a copy of the progress of every started quest, for save files
+func (qs *QuestSystem) Progress() map[string]types.QuestProgress {
+	progress := make(map[string]types.QuestProgress, len(qs.progress))
+	for key, p := range qs.progress {
+		progress[key] = types.QuestProgress{State: p.State, Progress: append([]int(nil), p.Progress...)}
+	}
+	return progress
+}
+
+// State returns where the player stands in a quest
+func (qs *QuestSystem) State(key string) types.QuestState {
+	if progress, started := qs.progress[key]; started {
+		return progress.State
+	}
+	return types.QuestNotStarted
+}
+
+// Start makes a quest active, false when it does not exist or was already started
+func (qs *QuestSystem) Start(key string) bool {
+	quest, exists := loaders.GetQuest(key)
+	if _, started := qs.progress[key]; !exists || started {
+		return false
+	}
+	qs.progress[key] = &types.QuestProgress{
+		State:    types.QuestActive,
+		Progress: make([]int, len(quest.Objectives)),
+	}
+	return true
+}
+
+// Quests returns the quests in a state with their progress, sorted by key
+func (qs *QuestSystem) Quests(state types.QuestState) ([]types.Quest, []types.QuestProgress) {
+	var quests []types.Quest
+	var progress []types.QuestProgress
+	for _, quest := range loaders.GetQuests() {
+		if p, started := qs.progress[quest.KeyName]; started && p.State == state {
+			quests = append(quests, quest)
+			progress = append(progress, *p)
+		}
+	}
+	return quests, progress
+}
+
+// EnemyDefeated counts a defeated enemy of an archetype, it returns the quests it completed
+func (qs *QuestSystem) EnemyDefeated(archetype string) []types.Quest {
+	return qs.advance(types.ObjectiveKill, func(objective types.QuestObjective, progress int) int {
+		if objective.Target != archetype {
+			return progress
+		}
+		return progress + 1
+	})
+}
+
+// ItemsChanged counts the items the player carries, it returns the quests it completed.
+// Collect objectives stay complete when the items are used or sold afterwards.
+func (qs *QuestSystem) ItemsChanged(player *types.Player) []types.Quest {
+	return qs.advance(types.ObjectiveCollect, func(objective types.QuestObjective, progress int) int {
+		return max(progress, player.CountItem(objective.Target))
+	})
+}
+
+// TalkedTo counts a talk with an NPC, it returns the quests it completed
+func (qs *QuestSystem) TalkedTo(npcID string) []types.Quest {
+	return qs.advance(types.ObjectiveTalk, func(objective types.QuestObjective, progress int) int {
+		if objective.Target != npcID {
+			return progress
+		}
+		return progress + 1
+	})
+}
+
+// Reached counts the player walking into a map region, it returns the quests it completed
+func (qs *QuestSystem) Reached(region string) []types.Quest {
+	return qs.advance(types.ObjectiveReach, func(objective types.QuestObjective, progress int) int {
+		if objective.Target != region {
+			return progress
+		}
+		return progress + 1
+	})
+}
+
+// advance updates the objectives of a kind in every active quest and completes the quests whose objectives are all done.
+// Talk objectives only move once every other objective of their quest is done.
+func (qs *QuestSystem) advance(kind types.ObjectiveKind, update func(objective types.QuestObjective, progress int) int) []types.Quest {
+	var completed []types.Quest
+	quests, _ := qs.Quests(types.QuestActive)
+	for _, quest := range quests {
+		progress := qs.progress[quest.KeyName]
+		for i, objective := range quest.Objectives {
+			if objective.Kind != kind || progress.Done(quest, i) {
+				continue
+			}
+			if kind == types.ObjectiveTalk && !qs.othersDone(quest, *progress, i) {
+				continue
+			}
+			progress.Progress[i] = min(update(objective, progress.Progress[i]), objective.Needed())
+		}
+
+		if qs.othersDone(quest, *progress, -1) {
+			progress.State = types.QuestCompleted
+			completed = append(completed, quest)
+		}
+	}
+	return completed
+}
+
+// othersDone reports whether every objective of a quest but the one at skip is done
+func (qs *QuestSystem) othersDone(quest types.Quest, progress types.QuestProgress, skip int) bool {
+	for i := range quest.Objectives {
+		if i != skip && !progress.Done(quest, i) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,248 @@
+package systems
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"projectred-rpg.com/game/types"
+)
+
+// CurrentSaveVersion is the save file format written by SaveSystem.
+// Adding a field needs no bump as long as its zero value is right for older saves, which then decode it as missing:
+// OpenedChests, DialogueFlags, Quests and the player's Credits, Weapon, Implants and Statuses were added this way.
+// Bump it when a field is renamed, removed, changes type or needs a value other than its zero one,
+// and register a migration for the previous version.
+const CurrentSaveVersion = 1
+
+// ErrSaveSlotEmpty is returned when loading a slot that has no save file
+var ErrSaveSlotEmpty = errors.New("save slot is empty")
+
+// SaveData is the serialized snapshot of a run
+type SaveData struct {
+	Version         int
+	Slot            int
+	SavedAt         time.Time
+	Player          types.Player
+	WorldID         int
+	StageNb         int
+	DefeatedEnemies []int                          // Spawn IDs of the stage enemies that are already defeated
+	OpenedChests    []string                       // Chests already opened in every stage, items left on the ground are not saved
+	DialogueFlags   []string                       // Flags set by dialogue actions
+	Quests          map[string]types.QuestProgress // Progress of the started quests, by key
+}
+
+// SaveSlotInfo summarizes a save slot for menus
+type SaveSlotInfo struct {
+	Slot    int
+	Exists  bool
+	SavedAt time.Time
+	WorldID int
+	StageNb int
+	Level   int
+}
+
+// SaveMigration upgrades a raw save document from its version to the next one
+type SaveMigration func(raw map[string]interface{}) error
+
+// SaveSystem reads and writes versioned save files, one JSON file per slot
+type SaveSystem struct {
+	dir        string
+	slots      int
+	migrations map[int]SaveMigration
+	slotInfos  []SaveSlotInfo // Summaries of the slots, nil until read and after a slot changes
+}
+
+// NewSaveSystem creates a save system storing its slots in dir
+func NewSaveSystem(dir string, slots int) *SaveSystem {
+	return &SaveSystem{
+		dir:        dir,
+		slots:      slots,
+		migrations: make(map[int]SaveMigration),
+	}
+}
+
+// RegisterMigration registers the hook upgrading saves written with fromVersion to fromVersion+1
+func (ss *SaveSystem) RegisterMigration(fromVersion int, migration SaveMigration) {
+	ss.migrations[fromVersion] = migration
+}
+
+// SlotCount returns the number of available save slots
+func (ss *SaveSystem) SlotCount() int {
+	return ss.slots
+}
+
+// slotPath returns the file path of a save slot
+func (ss *SaveSystem) slotPath(slot int) string {
+	return filepath.Join(ss.dir, fmt.Sprintf("slot-%d.json", slot))
+}
+
+// validSlot reports whether slot is within the configured range (1-based)
+func (ss *SaveSystem) validSlot(slot int) bool {
+	return slot >= 1 && slot <= ss.slots
+}
+
+// Save writes data to the given slot, stamping version, slot and time
+func (ss *SaveSystem) Save(slot int, data *SaveData) error {
+	if !ss.validSlot(slot) {
+		return fmt.Errorf("invalid save slot %d", slot)
+	}
+	if data == nil {
+		return errors.New("nothing to save")
+	}
+
+	data.Version = CurrentSaveVersion
+	data.Slot = slot
+	data.SavedAt = time.Now()
+
+	content, err := json.MarshalIndent(data, "", "\t")
+	if err != nil {
+		return fmt.Errorf("failed to encode save: %w", err)
+	}
+
+	if err := os.MkdirAll(ss.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create save directory: %w", err)
+	}
+
+	// Write to a temporary file first so a crash never leaves a half-written slot
+	path := ss.slotPath(slot)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, content, 0o644); err != nil {
+		return fmt.Errorf("failed to write save: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write save: %w", err)
+	}
+	ss.slotInfos = nil
+	return nil
+}
+
+// Load reads the given slot, migrating older save versions to the current format
+func (ss *SaveSystem) Load(slot int) (*SaveData, error) {
+	if !ss.validSlot(slot) {
+		return nil, fmt.Errorf("invalid save slot %d", slot)
+	}
+
+	content, err := os.ReadFile(ss.slotPath(slot))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrSaveSlotEmpty
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read save: %w", err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse save: %w", err)
+	}
+
+	if err := ss.migrate(raw); err != nil {
+		return nil, err
+	}
+
+	migrated, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-encode save: %w", err)
+	}
+
+	var data SaveData
+	if err := json.Unmarshal(migrated, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode save: %w", err)
+	}
+
+	// Sprites are not serialized, restore the default one
+	data.Player.SetSprite(types.CreateStickManSprite())
+	data.Slot = slot
+
+	return &data, nil
+}
+
+// migrate runs registered migrations until raw reaches CurrentSaveVersion
+func (ss *SaveSystem) migrate(raw map[string]interface{}) error {
+	version := 0
+	if v, ok := raw["Version"].(float64); ok {
+		version = int(v)
+	}
+
+	if version > CurrentSaveVersion {
+		return fmt.Errorf("save version %d is newer than supported version %d", version, CurrentSaveVersion)
+	}
+
+	for version < CurrentSaveVersion {
+		migration, ok := ss.migrations[version]
+		if !ok {
+			return fmt.Errorf("no migration registered for save version %d", version)
+		}
+		if err := migration(raw); err != nil {
+			return fmt.Errorf("failed to migrate save from version %d: %w", version, err)
+		}
+		version++
+		raw["Version"] = version
+	}
+	return nil
+}
+
+// Delete removes the save file of the given slot
+func (ss *SaveSystem) Delete(slot int) error {
+	if !ss.validSlot(slot) {
+		return fmt.Errorf("invalid save slot %d", slot)
+	}
+	err := os.Remove(ss.slotPath(slot))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	ss.slotInfos = nil
+	return nil
+}
+
+// ListSlots returns a summary of every slot, empty or not.
+// The slots are only read again after a Save or Delete, menus can call it on every frame.
+func (ss *SaveSystem) ListSlots() []SaveSlotInfo {
+	if ss.slotInfos == nil {
+		ss.slotInfos = ss.readSlots()
+	}
+	return slices.Clone(ss.slotInfos)
+}
+
+// readSlots loads every slot to summarize it
+func (ss *SaveSystem) readSlots() []SaveSlotInfo {
+	infos := make([]SaveSlotInfo, 0, ss.slots)
+	for slot := 1; slot <= ss.slots; slot++ {
+		info := SaveSlotInfo{Slot: slot}
+		if data, err := ss.Load(slot); err == nil {
+			info.Exists = true
+			info.SavedAt = data.SavedAt
+			info.WorldID = data.WorldID
+			info.StageNb = data.StageNb
+			info.Level = data.Player.Stats.Level
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// LatestSlot returns the most recently written slot, if any
+func (ss *SaveSystem) LatestSlot() (int, bool) {
+	latest := 0
+	var latestTime time.Time
+	if ss.slotInfos == nil {
+		ss.slotInfos = ss.readSlots()
+	}
+	for _, info := range ss.slotInfos {
+		if info.Exists && (latest == 0 || info.SavedAt.After(latestTime)) {
+			latest = info.Slot
+			latestTime = info.SavedAt
+		}
+	}
+	return latest, latest != 0
+}
+
+// HasSaves reports whether at least one slot holds a save
+func (ss *SaveSystem) HasSaves() bool {
+	_, ok := ss.LatestSlot()
+	return ok
+}
@@ -0,0 +1,70 @@
+package systems
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// firstSave is a version 1 save written before the fields added without a version bump existed
+const firstSave = `{
+	"Version": 1,
+	"Slot": 1,
+	"SavedAt": "2077-01-01T12:00:00Z",
+	"Player": {"Name": "V", "Stats": {"Level": 3}, "Inventory": [], "MaxInv": 10},
+	"WorldID": 1,
+	"StageNb": 2,
+	"DefeatedEnemies": [4]
+}`
+
+func TestLoadSaveWithoutAddedFields(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "slot-1.json"), []byte(firstSave), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := NewSaveSystem(dir, 3).Load(1)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if data.Player.Stats.Level != 3 || data.StageNb != 2 || len(data.DefeatedEnemies) != 1 {
+		t.Errorf("loaded %+v, want the saved run", data)
+	}
+	if data.OpenedChests != nil || data.DialogueFlags != nil || data.Quests != nil {
+		t.Errorf("fields missing from the save are not empty: %+v", data)
+	}
+	if data.Player.Credits != 0 || data.Player.Weapon != nil || data.Player.Implants[0].Installed() || len(data.Player.Statuses) != 0 {
+		t.Errorf("player fields missing from the save are not empty: %+v", data.Player)
+	}
+}
+
+func TestListSlotsReadsSlotsOnlyAfterChanges(t *testing.T) {
+	dir := t.TempDir()
+	ss := NewSaveSystem(dir, 2)
+	if ss.HasSaves() {
+		t.Fatal("empty save directory has saves")
+	}
+
+	if err := ss.Save(2, &SaveData{WorldID: 1, StageNb: 3}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if slot, ok := ss.LatestSlot(); !ok || slot != 2 {
+		t.Fatalf("latest slot is %d, %v after saving slot 2", slot, ok)
+	}
+
+	// A file changed behind the save system is not read again
+	if err := os.WriteFile(filepath.Join(dir, "slot-1.json"), []byte(firstSave), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if ss.ListSlots()[0].Exists {
+		t.Error("slot 1 was read again without a save or delete")
+	}
+
+	if err := ss.Delete(2); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	slots := ss.ListSlots()
+	if !slots[0].Exists || slots[1].Exists {
+		t.Errorf("slots are %+v after deleting slot 2, want only slot 1", slots)
+	}
+}
@@ -1,12 +1,18 @@
 package systems
 
 import (
+	"slices"
+	"sort"
+	"strings"
+
 	"projectred-rpg.com/game/entities"
+	"projectred-rpg.com/game/loaders"
 	"projectred-rpg.com/game/types"
 )
 
 type SpawnerSystem struct {
 	ActiveEnemies []*entities.Enemy
+	NPCs          []*types.NPC // NPCs of the stage, in declaration order
 	Stage         *types.Stage
 }
 
@@ -23,20 +29,113 @@ func (ss *SpawnerSystem) LoadStage(stage *types.Stage) {
 	ss.ActiveEnemies = make([]*entities.Enemy, 0)
 
 	// Create enemies from the stage's enemy spawn data
-	for _, enemySpawn := range stage.Enemies {
-		enemy := entities.NewEnemy(entities.Enemy{
-			Name:      enemySpawn.Name,
-			Force:     enemySpawn.Force,
-			Speed:     enemySpawn.Speed,
-			Defense:   enemySpawn.Defense,
-			Accuracy:  enemySpawn.Accuracy,
-			MaxHP:     enemySpawn.MaxHP,
-			CurrentHP: enemySpawn.CurrentHP,
-			ExpReward: enemySpawn.ExpReward,
-			Sprite:    enemySpawn.Sprite,
-			Position:  enemySpawn.Position,
+	for i, enemySpawn := range stage.Enemies {
+		ss.ActiveEnemies = append(ss.ActiveEnemies, spawnEnemy(i, enemySpawn))
+	}
+
+	ss.NPCs = make([]*types.NPC, 0, len(stage.NPCs))
+	for _, npcSpawn := range stage.NPCs {
+		ss.NPCs = append(ss.NPCs, spawnNPC(npcSpawn))
+	}
+}
+
+// spawnNPC creates the NPC of a spawn, drawn with the sprite of its type when it has none
+func spawnNPC(npcSpawn types.NPCSpawn) *types.NPC {
+	sprite := npcSpawn.Sprite
+	if sprite == "" {
+		sprite = CreateNPCSprite(npcSpawn.Type)
+	}
+	npc := types.NewNPC(npcSpawn.ID, npcSpawn.Name, npcSpawn.Type, npcSpawn.Position, sprite)
+	npc.Dialogue = npcSpawn.Dialogue
+	npc.Shop = npcSpawn.Shop
+	return npc
+}
+
+// ApplyNPCPlacements merges the NPCs placed on the stage map with the ones of the stage data.
+// NPCs are matched by ID and the map wins: a placement replaces the stage NPC with its ID, the others are added.
+func (ss *SpawnerSystem) ApplyNPCPlacements(placements []types.NPCPlacement) {
+	for _, placement := range placements {
+		npc := spawnNPC(types.NPCSpawn{
+			ID:       placement.ID,
+			Name:     placement.Name,
+			Type:     placement.Type,
+			Position: placement.Pos,
+			Sprite:   placement.Sprite,
+			Dialogue: placement.Props["dialogue"],
+			Shop:     placement.Props["shop"],
 		})
-		ss.ActiveEnemies = append(ss.ActiveEnemies, enemy)
+
+		i := slices.IndexFunc(ss.NPCs, func(stageNPC *types.NPC) bool { return stageNPC.ID == placement.ID })
+		if i < 0 {
+			ss.NPCs = append(ss.NPCs, npc)
+			continue
+		}
+		ss.NPCs[i] = npc
+	}
+}
+
+// GetNPC returns the stage NPC with an ID, nil when there is none
+func (ss *SpawnerSystem) GetNPC(id string) *types.NPC {
+	for _, npc := range ss.NPCs {
+		if npc.ID == id {
+			return npc
+		}
+	}
+	return nil
+}
+
+// Spawn adds an enemy of an archetype at pos.
+// It is not one of the stage spawns, so it is neither saved nor counted by DefeatedSpawnIDs.
+func (ss *SpawnerSystem) Spawn(archetype string, pos types.Position) (*entities.Enemy, bool) {
+	data, exists := loaders.GetEnemy(archetype)
+	if !exists {
+		return nil, false
+	}
+	enemy := spawnEnemy(-1, data.Spawn(types.EnemySpawn{Position: pos}))
+	ss.ActiveEnemies = append(ss.ActiveEnemies, enemy)
+	return enemy, true
+}
+
+// spawnEnemy creates the enemy of a spawn
+func spawnEnemy(spawnID int, enemySpawn types.EnemySpawn) *entities.Enemy {
+	return entities.NewEnemy(entities.Enemy{
+		SpawnID:     spawnID,
+		Archetype:   enemySpawn.Archetype,
+		Name:        enemySpawn.Name,
+		Force:       enemySpawn.Force,
+		Speed:       enemySpawn.Speed,
+		Defense:     enemySpawn.Defense,
+		Accuracy:    enemySpawn.Accuracy,
+		MaxHP:       enemySpawn.MaxHP,
+		CurrentHP:   enemySpawn.CurrentHP,
+		ExpReward:   enemySpawn.ExpReward,
+		Credits:     enemySpawn.Credits,
+		Resistances: enemySpawn.Resistances,
+		Loot:        enemySpawn.Loot,
+		AI:          enemySpawn.AI,
+		Sprite:      enemySpawn.Sprite,
+		Position:    enemySpawn.Position,
+		Patrol:      enemySpawn.Patrol,
+		Sight:       enemySpawn.Sight,
+	})
+}
+
+// ApplyMarkers moves stage enemies to the positions of the map enemy markers.
+// Each marker places the first not yet placed enemy whose name, archetype or sprite matches its reference.
+func (ss *SpawnerSystem) ApplyMarkers(markers []types.EnemyMarker) {
+	placed := make(map[*entities.Enemy]bool, len(markers))
+	for _, marker := range markers {
+		for _, enemy := range ss.ActiveEnemies {
+			if placed[enemy] {
+				continue
+			}
+			if strings.EqualFold(enemy.Name, marker.Ref) || strings.EqualFold(enemy.Archetype, marker.Ref) || strings.EqualFold(enemy.Sprite, marker.Ref) {
+				enemy.Position = marker.Pos
+				enemy.Home = marker.Pos
+				placed[enemy] = true
+				break
+			}
+		}
 	}
 }
 
@@ -62,6 +161,38 @@ func (ss *SpawnerSystem) RemoveDefeatedEnemies() {
 	ss.ActiveEnemies = activeEnemies
 }
 
+// ApplyDefeated marks the enemies with the given spawn IDs as defeated and removes them
+func (ss *SpawnerSystem) ApplyDefeated(spawnIDs []int) {
+	defeated := make(map[int]bool, len(spawnIDs))
+	for _, id := range spawnIDs {
+		defeated[id] = true
+	}
+	for _, enemy := range ss.ActiveEnemies {
+		if defeated[enemy.SpawnID] {
+			enemy.IsAlive = false
+		}
+	}
+	ss.RemoveDefeatedEnemies()
+}
+
+// DefeatedSpawnIDs returns the spawn IDs of the stage enemies that are no longer alive
+func (ss *SpawnerSystem) DefeatedSpawnIDs() []int {
+	if ss.Stage == nil {
+		return nil
+	}
+	alive := make(map[int]bool, len(ss.ActiveEnemies))
+	for _, enemy := range ss.GetActiveEnemies() {
+		alive[enemy.SpawnID] = true
+	}
+	defeated := make([]int, 0)
+	for i := range ss.Stage.Enemies {
+		if !alive[i] {
+			defeated = append(defeated, i)
+		}
+	}
+	return defeated
+}
+
 // CheckPlayerProximity checks if the player is within combat range of any enemy
 func (ss *SpawnerSystem) CheckPlayerProximity(playerPos types.Position, combatRange float64) *entities.Enemy {
 	for _, enemy := range ss.GetActiveEnemies() {
@@ -76,6 +207,20 @@ func (ss *SpawnerSystem) CheckPlayerProximity(playerPos types.Position, combatRa
 	return nil
 }
 
+// EnemiesWithinRange returns every living enemy within radius of the player, nearest first
+func (ss *SpawnerSystem) EnemiesWithinRange(playerPos types.Position, radius float64) []*entities.Enemy {
+	var enemies []*entities.Enemy
+	for _, enemy := range ss.GetActiveEnemies() {
+		if enemy.IsWithinRange(playerPos, radius) {
+			enemies = append(enemies, enemy)
+		}
+	}
+	sort.SliceStable(enemies, func(i, j int) bool {
+		return enemies[i].DistanceTo(playerPos) < enemies[j].DistanceTo(playerPos)
+	})
+	return enemies
+}
+
 // GetEnemyCount returns the total number of active enemies
 func (ss *SpawnerSystem) GetEnemyCount() int {
 	return len(ss.GetActiveEnemies())
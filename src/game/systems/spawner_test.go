@@ -0,0 +1,37 @@
+package systems
+
+import (
+	"testing"
+
+	"projectred-rpg.com/game/types"
+)
+
+func TestApplyNPCPlacementsMergesByID(t *testing.T) {
+	ss := NewSpawnerSystem()
+	ss.LoadStage(&types.Stage{NPCs: []types.NPCSpawn{
+		{ID: "vendor", Type: types.NPCMerchant, Position: types.Position{X: 5, Y: 5}, Shop: "street", Dialogue: "vendor"},
+		{ID: "guard", Type: types.NPCGuard, Position: types.Position{X: 9, Y: 9}},
+	}})
+
+	ss.ApplyNPCPlacements([]types.NPCPlacement{
+		{ID: "vendor", Type: types.NPCClinic, Pos: types.Position{X: 20, Y: 8}, Props: map[string]string{"shop": "ripperdoc"}},
+		{ID: "fixer", Type: types.NPCVillager, Pos: types.Position{X: 40, Y: 12}, Props: map[string]string{"dialogue": "fixer"}},
+	})
+
+	if len(ss.NPCs) != 3 {
+		t.Fatalf("got %d NPCs, want the 2 of the stage and the one only placed on the map", len(ss.NPCs))
+	}
+	vendor := ss.GetNPC("vendor")
+	if vendor.Pos != (types.Position{X: 20, Y: 8}) || vendor.Type != types.NPCClinic || vendor.Shop != "ripperdoc" {
+		t.Errorf("vendor is %+v, want the map placement", vendor)
+	}
+	if vendor.Dialogue != "" {
+		t.Errorf("vendor kept the dialogue %q of the stage declaration the map replaced", vendor.Dialogue)
+	}
+	if guard := ss.GetNPC("guard"); guard.Pos != (types.Position{X: 9, Y: 9}) {
+		t.Errorf("guard moved to %v without a map placement", guard.Pos)
+	}
+	if fixer := ss.GetNPC("fixer"); fixer == nil || fixer.Dialogue != "fixer" || fixer.Sprite == "" {
+		t.Errorf("fixer is %+v, want the map placement with the sprite of its type", fixer)
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+// DamageType is the kind of damage an attack deals, enemies can resist some kinds
+type DamageType string
+
+const (
+	DamagePhysical DamageType = "physical" // Blades, fists and bullets, the default
+	DamageEnergy   DamageType = "energy"   // Electric arcs, lasers and neural feedback
+)
+
+// AttackOutcome tells how an attack landed
+type AttackOutcome int
+
+const (
+	AttackMiss AttackOutcome = iota
+	AttackHit
+	AttackCritical
+)
+
+// AttackRoll describes an attack before it is resolved
+type AttackRoll struct {
+	Power      int // Damage before the target's defense
+	Accuracy   int // Accuracy of the attacker
+	DamageType DamageType
+	Effects    []ItemEffect // Applied to the fight when the attack lands
+}
+
+// AttackTarget holds the defender stats an attack is resolved against
+type AttackTarget struct {
+	Speed       int
+	Defense     int
+	Resistances map[DamageType]int // Percent of damage of each type ignored, negative for weaknesses
+}
+
+// AttackResult is the outcome of a resolved attack, shared by the combat system and the combat history
+type AttackResult struct {
+	Outcome   AttackOutcome
+	HitChance int // Percent chance the attack had to land
+	Damage    int // Damage dealt, 0 on a miss
+	Resisted  int // Damage prevented by resistances, negative when a weakness added damage
+	Effects   []ItemEffect
+}
+
+// Landed reports whether the attack hit its target
+func (ar AttackResult) Landed() bool {
+	return ar.Outcome != AttackMiss
+}
@@ -0,0 +1,41 @@
+package types
+
+import "math"
+
+// CatalogEntry is an item sold by a merchant, its data gives the name, description and base price
+type CatalogEntry struct {
+	Consumable string // Consumable key
+	Weapon     string // Weapon key
+	Implant    string // Implant key, installed on purchase
+	Stock      int    // Copies for sale in each world, 0 is unlimited
+}
+
+// CatalogSection groups catalog entries under a title
+type CatalogSection struct {
+	Name          string  // Localization key
+	PriceModifier float64 // Multiplies the prices of the section, 0 keeps them
+	Items         []CatalogEntry
+}
+
+// MerchantCatalog is the shop of a merchant loaded from assets/data/merchants
+type MerchantCatalog struct {
+	KeyName       string
+	Name          string  // Localization key of the merchant name
+	PriceModifier float64 // Multiplies every price of the shop, 0 keeps them
+	Clinic        bool    // Implant clinic: the sell tab removes installed implants
+	Sections      []CatalogSection
+}
+
+// Price returns the base price of an entry of section after the shop and section modifiers
+func (mc MerchantCatalog) Price(section CatalogSection, base int) int {
+	price := float64(base) * modifier(mc.PriceModifier) * modifier(section.PriceModifier)
+	return max(int(math.Round(price)), 1)
+}
+
+// modifier reads an unset price modifier as no change
+func modifier(m float64) float64 {
+	if m <= 0 {
+		return 1
+	}
+	return m
+}
@@ -0,0 +1,103 @@
+package types
+
+// ConditionKind identifies what a dialogue condition checks
+type ConditionKind string
+
+const (
+	ConditionHasItem ConditionKind = "has_item" // The player carries Quantity of Item, at least one when unset
+	ConditionLevel   ConditionKind = "level"    // The player level is at least Level
+	ConditionQuest   ConditionKind = "quest"    // Quest is in State
+	ConditionFlag    ConditionKind = "flag"     // Flag is set
+)
+
+// IsConditionKind reports whether kind names a condition dialogues know
+func IsConditionKind(kind ConditionKind) bool {
+	switch kind {
+	case ConditionHasItem, ConditionLevel, ConditionQuest, ConditionFlag:
+		return true
+	}
+	return false
+}
+
+// DialogueCondition gates a choice or a branch of a dialogue graph
+type DialogueCondition struct {
+	Kind     ConditionKind
+	Item     string // Consumable or weapon key
+	Quantity int
+	Level    int
+	Quest    string
+	State    QuestState
+	Flag     string
+	Not      bool // Holds when the check fails
+}
+
+// ActionKind identifies what a dialogue action does
+type ActionKind string
+
+const (
+	ActionGiveItem    ActionKind = "give_item"    // Gives Quantity of Item, one when unset
+	ActionStartCombat ActionKind = "start_combat" // Ends the dialogue and fights an Enemy archetype
+	ActionOpenShop    ActionKind = "open_shop"    // Ends the dialogue and opens the Shop catalog
+	ActionSetFlag     ActionKind = "set_flag"     // Sets Flag, or clears it with Clear
+	ActionStartQuest  ActionKind = "start_quest"  // Makes Quest active
+)
+
+// IsActionKind reports whether kind names an action dialogues know
+func IsActionKind(kind ActionKind) bool {
+	switch kind {
+	case ActionGiveItem, ActionStartCombat, ActionOpenShop, ActionSetFlag, ActionStartQuest:
+		return true
+	}
+	return false
+}
+
+// Ends reports whether the action closes the dialogue it is run from
+func (kind ActionKind) Ends() bool {
+	return kind == ActionStartCombat || kind == ActionOpenShop
+}
+
+// DialogueAction changes the game when a node is shown or a choice is picked
+type DialogueAction struct {
+	Kind     ActionKind
+	Item     string
+	Quantity int
+	Enemy    string // Enemy archetype key
+	Shop     string // Merchant catalog key
+	Flag     string
+	Clear    bool
+	Quest    string // Quest key
+}
+
+// DialogueChoice is an answer the player can pick at a node
+type DialogueChoice struct {
+	Text       string              // Localization key
+	Next       string              // Node shown once picked, empty ends the dialogue
+	Conditions []DialogueCondition // The choice is hidden unless every condition holds
+	Actions    []DialogueAction    // Run when picked
+}
+
+// DialogueBranch sends the dialogue to Next when every condition holds
+type DialogueBranch struct {
+	Conditions []DialogueCondition
+	Next       string
+}
+
+// DialogueNode is a line of a dialogue graph.
+// Nodes without Text are skipped, which lets a node made of branches route the dialogue.
+type DialogueNode struct {
+	Speaker  string           // Localization key, the graph speaker when empty
+	Text     string           // Localization key
+	Args     []any            `json:"-"` // Arguments for text formatting, graphs from files get the player name
+	Actions  []DialogueAction // Run when the node is shown
+	Choices  []DialogueChoice
+	Branches []DialogueBranch // Checked in order once the node is left without a choice
+	Next     string           // Node shown when no branch holds, empty ends the dialogue
+}
+
+// DialogueGraph is a conversation loaded from assets/data/dialogues, NPCs name it by KeyName
+type DialogueGraph struct {
+	KeyName string
+	Speaker string // Localization key of the default speaker
+	Start   string // First node
+	Nodes   map[string]DialogueNode
+}
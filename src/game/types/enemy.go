@@ -1,5 +1,10 @@
 package types
 
+import (
+	"cmp"
+	"math/rand"
+)
+
 type EnemyStats struct {
 	Force     int
 	Speed     int
@@ -9,15 +14,151 @@ type EnemyStats struct {
 	CurrentHP int
 }
 
+// EnemySpawn places an enemy in a stage. With an Archetype, every stat left at zero comes from the archetype.
 type EnemySpawn struct {
-	Name      string
-	Force     int
-	Speed     int
-	Defense   int
-	Accuracy  int
-	MaxHP     int
-	CurrentHP int
-	Position  Position
-	ExpReward int
-	Sprite    string
+	Archetype   string // Key of an enemy archetype of assets/data/enemies, empty for a fully inline enemy
+	Name        string
+	Force       int
+	Speed       int
+	Defense     int
+	Accuracy    int
+	MaxHP       int
+	CurrentHP   int
+	Position    Position
+	ExpReward   int
+	Credits     int                // Credits dropped when defeated
+	Resistances map[DamageType]int // Percent of damage of each type ignored, negative for weaknesses
+	Sprite      string
+	Loot        LootTable
+	AI          AIProfile
+	Sight       int        // Distance at which it notices the player in exploration
+	Patrol      []Position // Waypoints walked in a loop while it has not noticed the player
+}
+
+// EnemyArchetype describes a kind of enemy loaded from assets/data/enemies, stages spawn it by KeyName
+type EnemyArchetype struct {
+	KeyName     string
+	Name        string
+	Force       int
+	Speed       int
+	Defense     int
+	Accuracy    int
+	MaxHP       int
+	ExpReward   int
+	Credits     int
+	Resistances map[DamageType]int
+	Sprite      string
+	Loot        LootTable
+	AI          AIProfile
+	Sight       int
+}
+
+// Spawn returns the spawn with the archetype filling every field it leaves unset
+func (ea EnemyArchetype) Spawn(spawn EnemySpawn) EnemySpawn {
+	spawn.Archetype = ea.KeyName
+	spawn.Name = cmp.Or(spawn.Name, ea.Name)
+	spawn.Force = cmp.Or(spawn.Force, ea.Force)
+	spawn.Speed = cmp.Or(spawn.Speed, ea.Speed)
+	spawn.Defense = cmp.Or(spawn.Defense, ea.Defense)
+	spawn.Accuracy = cmp.Or(spawn.Accuracy, ea.Accuracy)
+	spawn.MaxHP = cmp.Or(spawn.MaxHP, ea.MaxHP)
+	spawn.CurrentHP = cmp.Or(spawn.CurrentHP, spawn.MaxHP)
+	spawn.ExpReward = cmp.Or(spawn.ExpReward, ea.ExpReward)
+	spawn.Credits = cmp.Or(spawn.Credits, ea.Credits)
+	spawn.Sprite = cmp.Or(spawn.Sprite, ea.Sprite)
+	spawn.Sight = cmp.Or(spawn.Sight, ea.Sight)
+	if spawn.Resistances == nil {
+		spawn.Resistances = ea.Resistances
+	}
+	if len(spawn.Loot.Entries) == 0 {
+		spawn.Loot = ea.Loot
+	}
+	if len(spawn.AI.Actions) == 0 {
+		spawn.AI = ea.AI
+	}
+	return spawn
+}
+
+// LootTable lists what an enemy can drop, one entry is drawn by weight
+type LootTable struct {
+	Entries []LootEntry
+}
+
+// LootEntry is a possible drop of a loot table, an entry without Item drops nothing
+type LootEntry struct {
+	Item     string // Key of a consumable or weapon
+	Quantity int
+	Weight   int
 }
+
+// Draw picks an entry by weight, false when the table is empty or the drawn entry drops nothing
+func (lt LootTable) Draw(rng *rand.Rand) (LootEntry, bool) {
+	total := 0
+	for _, entry := range lt.Entries {
+		total += max(entry.Weight, 0)
+	}
+	if total == 0 {
+		return LootEntry{}, false
+	}
+
+	roll := rng.Intn(total)
+	for _, entry := range lt.Entries {
+		weight := max(entry.Weight, 0)
+		if roll < weight {
+			return entry, entry.Item != ""
+		}
+		roll -= weight
+	}
+	return LootEntry{}, false
+}
+
+// EnemyAction is an action an enemy can take on its turn
+type EnemyAction string
+
+const (
+	ActionAttack        EnemyAction = "attack"
+	ActionSpecialAttack EnemyAction = "special_attack"
+	ActionDefend        EnemyAction = "defend"
+	ActionHeal          EnemyAction = "heal"
+	ActionTaunt         EnemyAction = "taunt"
+)
+
+// IsEnemyAction reports whether action names an action enemies know
+func IsEnemyAction(action EnemyAction) bool {
+	switch action {
+	case ActionAttack, ActionSpecialAttack, ActionDefend, ActionHeal, ActionTaunt:
+		return true
+	}
+	return false
+}
+
+// AIProfile holds the weighted actions an enemy picks from on its turns
+type AIProfile struct {
+	Actions []AIAction
+}
+
+// AIAction is a weighted action of an AI profile, it can only be picked while its conditions hold
+type AIAction struct {
+	Action    EnemyAction
+	Weight    int
+	HPBelow   int  // Percent of max health the enemy must be under, 0 for any health
+	FirstTurn bool // Only on the first turn of the enemy in the fight
+	Cooldown  int  // Turns of the enemy before the action can be picked again
+}
+
+// DefaultAIProfile is used by enemies without a profile: mostly attacks, sometimes defends
+var DefaultAIProfile = AIProfile{
+	Actions: []AIAction{
+		{Action: ActionAttack, Weight: 80},
+		{Action: ActionDefend, Weight: 20},
+	},
+}
+
+// AlertState tells what an enemy is doing in exploration
+type AlertState int
+
+const (
+	AlertIdle   AlertState = iota // Stands at or walks back to its post
+	AlertPatrol                   // Walks its patrol waypoints
+	AlertChase                    // Noticed the player and runs after them
+)
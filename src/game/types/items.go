@@ -4,19 +4,120 @@ type Item struct {
 	Type        ItemType
 	Name        string
 	Description string
+	Key         string // Data key of the item, consumables with the same key stack
+	Quantity    int    // Stack size, 0 is read as a single item
+	Value       int    // Price paid for one item, merchants buy it back for a share of it
 }
 
-// Renomme Weapon en WeaponData pour éviter le conflit
+// Count returns the number of items in the stack
+func (i Item) Count() int {
+	if i.Quantity < 1 {
+		return 1
+	}
+	return i.Quantity
+}
+
+// Stackable reports whether copies of the item share one inventory slot
+func (i Item) Stackable() bool {
+	return i.Type == Consumable && i.Key != ""
+}
+
+// GroundItem is an item lying on the map until the player picks it up
+type GroundItem struct {
+	Item Item
+	Pos  Position
+}
+
+// EffectKind identifies what a consumable does when used
+type EffectKind string
+
+const (
+	EffectHeal        EffectKind = "heal"         // Restores Amount health points
+	EffectHealPercent EffectKind = "heal_percent" // Restores Amount percent of the max health
+	EffectBuff        EffectKind = "buff"         // Adds Amount to Stat for Turns turns
+	EffectStun        EffectKind = "stun"         // The enemy loses its next Turns turns
+	EffectFlee        EffectKind = "flee"         // Leaves combat without fail
+	EffectDamage      EffectKind = "damage"       // Deals Amount damage to the enemy ignoring its defense, used by implant abilities
+	EffectBleed       EffectKind = "bleed"        // The enemy bleeds Amount health points at the start of its next Turns turns
+	EffectBurn        EffectKind = "burn"         // The enemy burns Amount health points at the start of its next Turns turns
+)
+
+// ItemEffect is one effect applied when a consumable is used
+type ItemEffect struct {
+	Kind   EffectKind
+	Amount int
+	Stat   string // Force, Speed, Defense or Accuracy for buffs
+	Turns  int
+}
+
+// ConsumableData describes a consumable loaded from assets/data/consumables
+type ConsumableData struct {
+	KeyName     string
+	Name        string // Localization key
+	Description string // Localization key
+	Price       int
+	Effects     []ItemEffect
+}
+
+// NewItem returns a stack of quantity items of this consumable
+func (cd ConsumableData) NewItem(quantity int) Item {
+	return Item{
+		Type:        Consumable,
+		Name:        cd.Name,
+		Description: cd.Description,
+		Key:         cd.KeyName,
+		Quantity:    quantity,
+		Value:       cd.Price,
+	}
+}
+
+// WeaponType tells how a weapon is used
+type WeaponType int
+
+const (
+	Melee WeaponType = iota
+	Ranged
+)
+
+// WeaponData describes a weapon loaded from assets/data/weapons
 type WeaponData struct {
-	KeyName string
-	Type    int
-	Attacks []Attack
+	KeyName     string
+	Name        string // Localization key
+	Description string // Localization key
+	Type        WeaponType
+	Price       int
+	Attacks     []Attack
 }
 
+// Attack is a combat action granted by a weapon
 type Attack struct {
-	KeyName  string
-	KeyDesc  string
-	Damage   int
-	Duration int
-	CoolDown int
+	KeyName    string
+	Name       string // Localization key shown in the combat menu
+	KeyDesc    string
+	Damage     int          // Added to the Force of the attacker
+	Duration   int          // Animation length in milliseconds
+	CoolDown   int          // Player turns to wait before using the attack again
+	DamageType DamageType   // Physical when unset
+	Effects    []ItemEffect // Applied to the fight when the attack lands
+}
+
+// NewItem returns the inventory item of this weapon
+func (wd WeaponData) NewItem() Item {
+	return Item{
+		Type:        Weapon,
+		Name:        wd.Name,
+		Description: wd.Description,
+		Key:         wd.KeyName,
+		Value:       wd.Price,
+	}
+}
+
+// GetAttack returns the attack of the weapon with the given key
+func (wd WeaponData) GetAttack(keyName string) (Attack, bool) {
+	for _, attack := range wd.Attacks {
+		if attack.KeyName == keyName {
+			return attack, true
+		}
+	}
+	return Attack{}, false
 }
@@ -1,12 +1,15 @@
 package types
 
-// TransitionZone represents an area that allows transitioning to the next stage/world
+// TransitionZone represents an exit area leading to another stage or world
 type TransitionZone struct {
-	X      int
-	Y      int
-	Width  int
-	Height int
-	Active bool
+	X           int
+	Y           int
+	Width       int
+	Height      int
+	TargetWorld int    // 0 keeps the current world
+	TargetStage int    // 0 means the next stage, or the first stage of the next world
+	Quest       string // Quest to complete before the exit opens, empty when clearing the stage is enough
+	Active      bool
 }
 
 // IsInZone checks if a position is within the transition zone
@@ -17,13 +20,65 @@ func (tz *TransitionZone) IsInZone(x, y int) bool {
 	return x >= tz.X && x < tz.X+tz.Width && y >= tz.Y && y < tz.Y+tz.Height
 }
 
+// HasTarget reports whether the zone names an explicit destination
+func (tz *TransitionZone) HasTarget() bool {
+	return tz.TargetWorld != 0 || tz.TargetStage != 0
+}
+
+// EnemyMarker places a stage enemy on the map.
+// Ref matches the Name, Archetype or Sprite of an enemy declared for the stage.
+type EnemyMarker struct {
+	Ref string
+	Pos Position
+}
+
+// NPCPlacement declares an NPC standing on the map, it replaces the stage NPC with the same ID
+type NPCPlacement struct {
+	ID     string
+	Name   string
+	Type   NPCType
+	Pos    Position
+	Sprite string
+	Props  map[string]string // Extra attributes such as the dialogue or shop to use
+}
+
+// Chest is a container placed on the map, its contents are given once when it is opened
+type Chest struct {
+	ID       string
+	Pos      Position
+	Contents []LootEntry // Every entry is given, weights are ignored
+	Credits  int
+	Opened   bool
+}
+
+// Region is a named rectangle of the map
+type Region struct {
+	Name   string
+	X      int
+	Y      int
+	Width  int
+	Height int
+}
+
+// Contains checks if a position is within the region
+func (r *Region) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
 // TileMap represents a simple ASCII tile map to render in the game area.
 // Each string in Tiles represents one row; each rune is rendered as-is.
+// The metadata fields come from the header block of the .map file.
 type TileMap struct {
-	Width          int
-	Height         int
-	Tiles          [][]rune
-	TransitionZone *TransitionZone
+	Width           int
+	Height          int
+	Tiles           [][]rune
+	TransitionZones []*TransitionZone
+	PlayerSpawn     *Position // nil when the map does not declare a spawn
+	EnemyMarkers    []EnemyMarker
+	NPCs            []NPCPlacement
+	Chests          []Chest
+	Regions         []Region
+	Legend          TileLegend // Set by the loader from the world definition
 }
 
 // NewTileMap constructs a TileMap from lines of text.
@@ -40,32 +95,9 @@ func NewTileMap(lines []string) *TileMap {
 	}
 	tm.Width = maxW
 
-	// Create default transition zone in bottom-right corner
-	tm.TransitionZone = &TransitionZone{
-		X:      maxW - 3,
-		Y:      len(lines) - 3,
-		Width:  2,
-		Height: 2,
-		Active: false, // Initially inactive
-	}
-
 	return tm
 }
 
-// SetCustomTransitionZone sets a custom transition zone with specified coordinates
-func (tm *TileMap) SetCustomTransitionZone(x, y, width, height int) {
-	if tm == nil {
-		return
-	}
-	tm.TransitionZone = &TransitionZone{
-		X:      x,
-		Y:      y,
-		Width:  width,
-		Height: height,
-		Active: false, // Initially inactive
-	}
-}
-
 // At returns the rune at x,y if within bounds; otherwise space.
 func (tm *TileMap) At(x, y int) rune {
 	if tm == nil || y < 0 || y >= len(tm.Tiles) {
@@ -78,17 +110,80 @@ func (tm *TileMap) At(x, y int) rune {
 	return row[x]
 }
 
-// ActivateTransitionZone enables the transition zone for stage progression
-func (tm *TileMap) ActivateTransitionZone() {
-	if tm.TransitionZone != nil {
-		tm.TransitionZone.Active = true
+// TileAt returns the glyph at x,y and its legend properties, if the legend defines it
+func (tm *TileMap) TileAt(x, y int) (rune, TileProps, bool) {
+	ch := tm.At(x, y)
+	if tm == nil {
+		return ch, TileProps{}, false
 	}
+	props, ok := tm.Legend.Lookup(ch)
+	return ch, props, ok
 }
 
-// IsInTransitionZone checks if a player position is in the active transition zone
+// ActivateTransitionZones enables the exits of the map for stage progression,
+// the ones waiting for a quest only once completed reports it done
+func (tm *TileMap) ActivateTransitionZones(completed func(quest string) bool) {
+	if tm == nil {
+		return
+	}
+	for _, zone := range tm.TransitionZones {
+		zone.Active = zone.Quest == "" || completed(zone.Quest)
+	}
+}
+
+// TransitionZoneAt returns the active exit containing a position, or nil
+func (tm *TileMap) TransitionZoneAt(x, y int) *TransitionZone {
+	if tm == nil {
+		return nil
+	}
+	for _, zone := range tm.TransitionZones {
+		if zone.IsInZone(x, y) {
+			return zone
+		}
+	}
+	return nil
+}
+
+// UnlockedExit returns the first exit whose quest completed reports done, whether or not the stage is cleared
+func (tm *TileMap) UnlockedExit(completed func(quest string) bool) *TransitionZone {
+	if tm == nil {
+		return nil
+	}
+	for _, zone := range tm.TransitionZones {
+		if zone.Quest == "" || completed(zone.Quest) {
+			return zone
+		}
+	}
+	return nil
+}
+
+// IsInTransitionZone checks if a player position is in an active transition zone
 func (tm *TileMap) IsInTransitionZone(x, y int) bool {
-	if tm.TransitionZone == nil {
-		return false
+	return tm.TransitionZoneAt(x, y) != nil
+}
+
+// RegionAt returns the first named region containing a position, or nil
+func (tm *TileMap) RegionAt(x, y int) *Region {
+	if tm == nil {
+		return nil
+	}
+	for i := range tm.Regions {
+		if tm.Regions[i].Contains(x, y) {
+			return &tm.Regions[i]
+		}
+	}
+	return nil
+}
+
+// GetRegion returns the region with the given name, or nil
+func (tm *TileMap) GetRegion(name string) *Region {
+	if tm == nil {
+		return nil
+	}
+	for i := range tm.Regions {
+		if tm.Regions[i].Name == name {
+			return &tm.Regions[i]
+		}
 	}
-	return tm.TransitionZone.IsInZone(x, y)
+	return nil
 }
@@ -1,5 +1,7 @@
 package types
 
+import "strings"
+
 // NPCType represents different types of NPCs
 type NPCType string
 
@@ -8,6 +10,7 @@ const (
 	NPCMerchant NPCType = "merchant" 
 	NPCVillager NPCType = "villager"
 	NPCAethelgard NPCType = "aethelgard"
+	NPCClinic NPCType = "clinic"
 )
 
 // NPC represents a non-player character
@@ -17,7 +20,20 @@ type NPC struct {
 	Type     NPCType
 	Pos      Position
 	Sprite   string
-	IsActive bool // Whether the NPC can be interacted with
+	IsActive bool   // Whether the NPC can be interacted with
+	Shop     string // Merchant or clinic catalog key, empty when the NPC does not trade
+	Dialogue string // Dialogue graph key, empty when the NPC only greets the player
+}
+
+// NPCSpawn declares an NPC standing on a stage
+type NPCSpawn struct {
+	ID       string
+	Name     string // Localization key
+	Type     NPCType
+	Position Position
+	Sprite   string // Sprite drawn on the map, the sprite of the type when empty
+	Dialogue string // Dialogue graph key
+	Shop     string // Merchant or clinic catalog key
 }
 
 // NewNPC creates a new NPC with the specified parameters
@@ -57,6 +73,20 @@ func abs(x int) int {
 	return x
 }
 
+// Tiles returns the map tiles covered by the NPC sprite, which nothing can walk through.
+// Blank sprite cells are drawn as the map, so they stay walkable.
+func (n *NPC) Tiles() []Position {
+	var tiles []Position
+	for i, line := range strings.Split(n.Sprite, "\n") {
+		for j, ch := range []rune(line) {
+			if ch != ' ' {
+				tiles = append(tiles, Position{X: n.Pos.X + j, Y: n.Pos.Y + i})
+			}
+		}
+	}
+	return tiles
+}
+
 // GetSprite returns the NPC's sprite
 func (n *NPC) GetSprite() string {
 	return n.Sprite
@@ -5,7 +5,7 @@
 //   - Player: Character data, stats, inventory, and behavior methods
 //   - Class: Character class definitions with base stats
 //   - PlayerStats: Level, experience, and combat statistics
-//   - Implant: Cybernetic enhancements with stat bonuses
+//   - Implant: Cybernetic enhancements with stat bonuses and combat abilities
 //
 // All types include their associated methods, following Go best practices
 // of defining methods in the same package as the type.
@@ -34,12 +34,23 @@ type BonusStats struct {
 	Accuracy int
 }
 
+// Implant is a cybernetic enhancement installed in a body slot, loaded from assets/data/implants
 type Implant struct {
+	KeyName     string
 	Type        BodyParts
 	Bonus       BonusStats
 	Name        string
 	Description string
-	Cooldown    int
+	Cooldown    int             // Rounds before the ability can be used again
+	Price       int             // Base price at implant clinics
+	Ability     *ImplantAbility // Ability usable in combat, nil for passive implants
+}
+
+// ImplantAbility is the active ability of an implant, its effects work like consumable effects
+type ImplantAbility struct {
+	Name       string     // Localization key
+	DamageType DamageType // Type of the damage effects, physical when unset
+	Effects    []ItemEffect
 }
 
 type PlayerStats struct {
@@ -64,8 +75,86 @@ type Player struct {
 	sprite string
 
 	Inventory []Item
-	Implants  [5]Implant // "tete", "brasD", etc - fixed size array
+	Implants  [5]Implant // Installed implants indexed by BodyParts, empty slots have no KeyName
 	MaxInv    int
+	Credits   int        // Currency earned from fights and cleared stages, spent at merchants
+	Weapon    *Item      // Equipped weapon, nil when fighting bare-handed
+	Statuses  StatusList // Buffs and debuffs of the current fight
+}
+
+// Installed reports whether the implant slot holds an implant
+func (i Implant) Installed() bool {
+	return i.KeyName != ""
+}
+
+// Item returns the implant as an item, used to list it in shops
+func (i Implant) Item() Item {
+	return Item{
+		Name:        i.Name,
+		Description: i.Description,
+		Type:        Upgrade,
+		Key:         i.KeyName,
+		Value:       i.Price,
+	}
+}
+
+// Add returns the stats with the bonus added
+func (b BonusStats) Add(other BonusStats) BonusStats {
+	return BonusStats{
+		Force:    b.Force + other.Force,
+		Speed:    b.Speed + other.Speed,
+		Defense:  b.Defense + other.Defense,
+		Accuracy: b.Accuracy + other.Accuracy,
+	}
+}
+
+// AddStat adds amount to the named stat (Force, Speed, Defense or Accuracy), reporting whether the stat exists
+func (ps *PlayerStats) AddStat(stat string, amount int) bool {
+	switch stat {
+	case "Force":
+		ps.Force += amount
+	case "Speed":
+		ps.Speed += amount
+	case "Defense":
+		ps.Defense += amount
+	case "Accuracy":
+		ps.Accuracy += amount
+	default:
+		return false
+	}
+	return true
+}
+
+// IsStat reports whether stat names a stat that buffs and bonuses can change
+func IsStat(stat string) bool {
+	return (&PlayerStats{}).AddStat(stat, 0)
+}
+
+// ImplantBonus returns the bonuses of every installed implant added together
+func (p *Player) ImplantBonus() BonusStats {
+	var bonus BonusStats
+	for _, implant := range p.Implants {
+		if implant.Installed() {
+			bonus = bonus.Add(implant.Bonus)
+		}
+	}
+	return bonus
+}
+
+// EffectiveStats returns the stats used by combat and movement: base stats plus implant bonuses and active statuses
+func (p *Player) EffectiveStats() PlayerStats {
+	stats := p.Stats
+	bonus := p.ImplantBonus()
+	stats.Force += bonus.Force
+	stats.Speed += bonus.Speed
+	stats.Defense += bonus.Defense
+	stats.Accuracy += bonus.Accuracy
+
+	stats.Force += p.Statuses.StatBonus("Force")
+	stats.Speed += p.Statuses.StatBonus("Speed")
+	stats.Defense += p.Statuses.StatBonus("Defense")
+	stats.Accuracy += p.Statuses.StatBonus("Accuracy")
+	return stats
 }
 
 // FreeRoam Movement Methods
@@ -109,15 +198,50 @@ func CreateStickManSprite() string {
 
 // CalculateDamage computes damage dealt to an enemy considering player's force and enemy's defense
 func (p *Player) CalculateDamage(defense int) int {
-	damage := p.Stats.Force - defense
+	damage := p.EffectiveStats().Force - defense
 	if damage < 0 {
 		damage = 0
 	}
 	return damage
 }
 
-// AddItemToInventory adds an item to the player's inventory if there's space
+// EquipWeapon equips the weapon at index, the previously equipped weapon takes its inventory slot
+func (p *Player) EquipWeapon(index int) bool {
+	if index < 0 || index >= len(p.Inventory) || p.Inventory[index].Type != Weapon {
+		return false
+	}
+
+	weapon := p.Inventory[index]
+	if p.Weapon != nil {
+		p.Inventory[index] = *p.Weapon
+	} else {
+		p.RemoveItemFromInventory(index)
+	}
+	p.Weapon = &weapon
+	return true
+}
+
+// UnequipWeapon puts the equipped weapon back in the inventory if there's space
+func (p *Player) UnequipWeapon() bool {
+	if p.Weapon == nil || !p.AddItemToInventory(*p.Weapon) {
+		return false
+	}
+	p.Weapon = nil
+	return true
+}
+
+// AddItemToInventory adds an item to the player's inventory if there's space.
+// Stackable items join an existing stack with the same key without taking a new slot.
 func (p *Player) AddItemToInventory(item Item) bool {
+	if item.Stackable() {
+		for i := range p.Inventory {
+			if p.Inventory[i].Stackable() && p.Inventory[i].Key == item.Key {
+				p.Inventory[i].Quantity = p.Inventory[i].Count() + item.Count()
+				return true
+			}
+		}
+	}
+
 	if len(p.Inventory) >= p.MaxInv {
 		return false // Inventory full
 	}
@@ -125,6 +249,32 @@ func (p *Player) AddItemToInventory(item Item) bool {
 	return true
 }
 
+// ConsumeItem uses up one item of the stack at index, removing the slot when the stack is empty
+func (p *Player) ConsumeItem(index int) bool {
+	if index < 0 || index >= len(p.Inventory) {
+		return false // Invalid index
+	}
+	if p.Inventory[index].Count() > 1 {
+		p.Inventory[index].Quantity = p.Inventory[index].Count() - 1
+		return true
+	}
+	return p.RemoveItemFromInventory(index)
+}
+
+// CountItem returns how many items with the given key the player carries, the equipped weapon included
+func (p *Player) CountItem(key string) int {
+	count := 0
+	for _, item := range p.Inventory {
+		if item.Key == key {
+			count += item.Count()
+		}
+	}
+	if p.Weapon != nil && p.Weapon.Key == key {
+		count++
+	}
+	return count
+}
+
 // RemoveItemFromInventory removes an item from the player's inventory by index
 func (p *Player) RemoveItemFromInventory(index int) bool {
 	if index < 0 || index >= len(p.Inventory) {
@@ -134,11 +284,14 @@ func (p *Player) RemoveItemFromInventory(index int) bool {
 	return true
 }
 
-func (p *Player) AddExperience(exp int) {
+// AddExperience adds exp to the player, levelling up as many times as it allows, and returns the levels gained
+func (p *Player) AddExperience(exp int) int {
+	levels := 0
 	p.Stats.Exp += float32(exp)
 	for p.Stats.Exp >= float32(p.Stats.NextLevelExp) {
 		p.Stats.Exp -= float32(p.Stats.NextLevelExp)
 		p.Stats.Level++
+		levels++
 		p.Stats.NextLevelExp = int(float32(p.Stats.NextLevelExp) * 1.5) // Increase next level exp requirement
 
 		// Increase stats on level up
@@ -151,6 +304,23 @@ func (p *Player) AddExperience(exp int) {
 		// Heal player to full health on level up
 		p.Stats.CurrentHP = p.Stats.MaxHP
 	}
+	return levels
+}
+
+// AddCredits adds amount to the player's wallet, negative amounts are ignored
+func (p *Player) AddCredits(amount int) {
+	if amount > 0 {
+		p.Credits += amount
+	}
+}
+
+// SpendCredits takes amount from the wallet, reporting false without spending when it is short
+func (p *Player) SpendCredits(amount int) bool {
+	if amount < 0 || p.Credits < amount {
+		return false
+	}
+	p.Credits -= amount
+	return true
 }
 
 // GetPosition returns the player's X and Y coordinates
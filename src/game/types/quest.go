@@ -0,0 +1,70 @@
+package types
+
+// QuestState is the progress of the player in a quest
+type QuestState string
+
+const (
+	QuestNotStarted QuestState = "not_started"
+	QuestActive     QuestState = "active"
+	QuestCompleted  QuestState = "completed"
+)
+
+// ObjectiveKind identifies what an objective asks of the player
+type ObjectiveKind string
+
+const (
+	ObjectiveKill    ObjectiveKind = "kill"    // Defeat Count enemies of the Target archetype
+	ObjectiveCollect ObjectiveKind = "collect" // Carry Count items with the Target key
+	ObjectiveTalk    ObjectiveKind = "talk"    // Talk to the NPC with the Target ID once every other objective is complete
+	ObjectiveReach   ObjectiveKind = "reach"   // Walk into the map region named Target
+)
+
+// IsObjectiveKind reports whether kind names an objective quests know
+func IsObjectiveKind(kind ObjectiveKind) bool {
+	switch kind {
+	case ObjectiveKill, ObjectiveCollect, ObjectiveTalk, ObjectiveReach:
+		return true
+	}
+	return false
+}
+
+// QuestObjective is a step of a quest
+type QuestObjective struct {
+	Kind   ObjectiveKind
+	Target string
+	Count  int    // Times the objective must happen, 1 when unset
+	Text   string // Localization key
+}
+
+// Needed returns how many times the objective must happen
+func (qo QuestObjective) Needed() int {
+	return max(qo.Count, 1)
+}
+
+// QuestReward is given when every objective of a quest is complete
+type QuestReward struct {
+	Exp     int
+	Credits int
+	Items   []LootEntry
+}
+
+// Quest is a quest loaded from assets/data/quests
+type Quest struct {
+	KeyName     string
+	Name        string // Localization key
+	Description string // Localization key
+	AutoStart   bool   // Started with every new game instead of by a dialogue
+	Objectives  []QuestObjective
+	Reward      QuestReward
+}
+
+// QuestProgress is where the player stands in a quest, saved with the game
+type QuestProgress struct {
+	State    QuestState
+	Progress []int // Progress of each objective, in quest order
+}
+
+// Done reports whether the objective at index is complete
+func (qp QuestProgress) Done(quest Quest, index int) bool {
+	return index < len(qp.Progress) && qp.Progress[index] >= quest.Objectives[index].Needed()
+}
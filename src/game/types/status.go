@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StatusKind identifies a status effect a combatant carries during a fight
+type StatusKind string
+
+const (
+	StatusBuff      StatusKind = "buff"      // Adds Amount to Stat
+	StatusDefending StatusKind = "defending" // Adds Amount to Defense until its carrier is hit
+	StatusTaunted   StatusKind = "taunted"   // Removes Amount from Accuracy
+	StatusBleeding  StatusKind = "bleeding"  // Deals Amount damage when the turn of its carrier starts
+	StatusStunned   StatusKind = "stunned"   // Its carrier loses its turns
+	StatusBurning   StatusKind = "burning"   // Deals Amount damage when the turn of its carrier starts
+)
+
+// StackRule tells what happens when a status is applied to a combatant already carrying it
+type StackRule int
+
+const (
+	StackIndependent StackRule = iota // Every application is a separate status with its own duration
+	StackRefresh                      // The longest duration and the strongest amount are kept
+	StackIntensity                    // Amounts add up and the duration is refreshed
+	StackDuration                     // Durations add up
+)
+
+// StatusRule describes how a kind of status behaves
+type StatusRule struct {
+	Icon  string
+	Stat  string // Stat changed by the status, buffs name theirs in StatusEffect.Stat
+	Malus bool   // The amount is removed from the stat instead of added
+	Stack StackRule
+}
+
+var statusRules = map[StatusKind]StatusRule{
+	StatusBuff:      {Icon: "💪", Stack: StackIndependent},
+	StatusDefending: {Icon: "🧱", Stat: "Defense", Stack: StackRefresh},
+	StatusTaunted:   {Icon: "😤", Stat: "Accuracy", Malus: true, Stack: StackRefresh},
+	StatusBleeding:  {Icon: "🩸", Stack: StackIntensity},
+	StatusStunned:   {Icon: "💫", Stack: StackDuration},
+	StatusBurning:   {Icon: "🔥", Stack: StackRefresh},
+}
+
+// Rule returns how the status behaves, unknown kinds stack independently and change nothing
+func (k StatusKind) Rule() StatusRule {
+	return statusRules[k]
+}
+
+// IsStatus reports whether kind names a known status
+func IsStatus(kind StatusKind) bool {
+	_, ok := statusRules[kind]
+	return ok
+}
+
+// StatusEffect is a status carried by a combatant for a number of its turns
+type StatusEffect struct {
+	Kind   StatusKind
+	Stat   string // Stat changed by a buff
+	Amount int
+	Turns  int // Turns of its carrier left before it expires
+}
+
+// StatBonus returns what the status adds to stat
+func (s StatusEffect) StatBonus(stat string) int {
+	rule := s.Kind.Rule()
+	affected := rule.Stat
+	if affected == "" {
+		affected = s.Stat
+	}
+	if affected != stat {
+		return 0
+	}
+	if rule.Malus {
+		return -s.Amount
+	}
+	return s.Amount
+}
+
+// StatusList holds the statuses of a combatant
+type StatusList []StatusEffect
+
+// Add applies a status following the stacking rule of its kind
+func (sl *StatusList) Add(effect StatusEffect) {
+	effect.Turns = max(effect.Turns, 1)
+	rule := effect.Kind.Rule()
+	if rule.Stack != StackIndependent {
+		for i := range *sl {
+			current := &(*sl)[i]
+			if current.Kind != effect.Kind {
+				continue
+			}
+			switch rule.Stack {
+			case StackRefresh:
+				current.Turns = max(current.Turns, effect.Turns)
+				current.Amount = max(current.Amount, effect.Amount)
+			case StackIntensity:
+				current.Turns = max(current.Turns, effect.Turns)
+				current.Amount += effect.Amount
+			case StackDuration:
+				current.Turns += effect.Turns
+			}
+			return
+		}
+	}
+	*sl = append(*sl, effect)
+}
+
+// Has reports whether the list holds a status of kind
+func (sl StatusList) Has(kind StatusKind) bool {
+	for _, status := range sl {
+		if status.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove drops every status of kind
+func (sl *StatusList) Remove(kind StatusKind) {
+	remaining := (*sl)[:0]
+	for _, status := range *sl {
+		if status.Kind != kind {
+			remaining = append(remaining, status)
+		}
+	}
+	*sl = remaining
+}
+
+// StatBonus returns what every status of the list adds to stat
+func (sl StatusList) StatBonus(stat string) int {
+	bonus := 0
+	for _, status := range sl {
+		bonus += status.StatBonus(stat)
+	}
+	return bonus
+}
+
+// Tick counts one turn down on every status and returns the ones that expired
+func (sl *StatusList) Tick() []StatusEffect {
+	var expired []StatusEffect
+	remaining := (*sl)[:0]
+	for _, status := range *sl {
+		status.Turns--
+		if status.Turns > 0 {
+			remaining = append(remaining, status)
+			continue
+		}
+		expired = append(expired, status)
+	}
+	*sl = remaining
+	return expired
+}
+
+// Icons returns the icon and turns left of every status, separated by spaces
+func (sl StatusList) Icons() string {
+	icons := make([]string, len(sl))
+	for i, status := range sl {
+		icons[i] = fmt.Sprintf("%s%d", status.Kind.Rule().Icon, status.Turns)
+	}
+	return strings.Join(icons, " ")
+}
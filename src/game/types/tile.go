@@ -0,0 +1,71 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+)
+
+// TileProps describes how a map glyph behaves and looks
+type TileProps struct {
+	Walkable    bool
+	BlocksSight bool   // Enemies do not notice the player through the tile
+	Damage      int    // HP lost when stepping onto the tile
+	Slow        int    // Extra move inputs needed to leave the tile
+	Foreground  string // "#RRGGBB" or ANSI index, empty for default
+	Background  string
+	Bold        bool
+	Dim         bool
+	Description string // Localization key shown when inspecting the tile
+}
+
+// UnmarshalJSON decodes tile properties, tiles are walkable unless stated otherwise
+func (tp *TileProps) UnmarshalJSON(data []byte) error {
+	type rawTileProps TileProps
+	props := rawTileProps{Walkable: true}
+	if err := json.Unmarshal(data, &props); err != nil {
+		return err
+	}
+	*tp = TileProps(props)
+	return nil
+}
+
+// TileLegend maps map glyphs to their properties
+type TileLegend map[rune]TileProps
+
+// UnmarshalJSON decodes a legend whose keys are single-character strings
+func (tl *TileLegend) UnmarshalJSON(data []byte) error {
+	var raw map[string]TileProps
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	legend := make(TileLegend, len(raw))
+	for key, props := range raw {
+		if utf8.RuneCountInString(key) != 1 {
+			return fmt.Errorf("tile legend key %q must be a single character", key)
+		}
+		r, _ := utf8.DecodeRuneInString(key)
+		legend[r] = props
+	}
+	*tl = legend
+	return nil
+}
+
+// MarshalJSON encodes the legend with single-character string keys
+func (tl TileLegend) MarshalJSON() ([]byte, error) {
+	raw := make(map[string]TileProps, len(tl))
+	for r, props := range tl {
+		raw[string(r)] = props
+	}
+	return json.Marshal(raw)
+}
+
+// Lookup returns the properties of a glyph and whether the legend defines it
+func (tl TileLegend) Lookup(ch rune) (TileProps, bool) {
+	if tl == nil {
+		return TileProps{}, false
+	}
+	props, ok := tl[ch]
+	return props, ok
+}
@@ -10,6 +10,7 @@ type Stage struct {
 	StageNb        int
 	Name           string
 	Enemies        []EnemySpawn
+	NPCs           []NPCSpawn // Merged with the map's npc directives, a placement replaces the NPC with its ID
 	ClearingReward int
 	PlayerSpawn    Position
 }
@@ -19,6 +20,7 @@ type World struct {
 	Name           string
 	Stages         []Stage
 	ClearingReward int
+	Legend         TileLegend // Glyph properties shared by every stage map of the world
 }
 
 // GetStage returns a pointer to the stage with the given number.
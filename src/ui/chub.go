@@ -18,6 +18,7 @@ type CAction struct {
 	Target     string
 	Damage     int
 	Message    string
+	Result     *types.AttackResult // Resolved attack, nil for other actions
 }
 
 type CHistory struct {
@@ -32,7 +33,8 @@ type CombatHud struct {
 	TermHeight int
 
 	Player      *types.Player
-	Enemy       *entities.Enemy
+	Enemy       *entities.Enemy   // Enemy targeted by the player
+	Enemies     []*entities.Enemy // Every enemy of the fight, defeated ones included
 	CurrentTurn types.CombatState
 	History     *CHistory
 	LocManager  *engine.LocalizationManager
@@ -41,9 +43,27 @@ type CombatHud struct {
 	AvailableActions []string
 	ShowHistory      bool
 
+	skillActions map[string]SkillAction // Weapon attacks and implant abilities listed before the base actions
+
+	turnOrder []*entities.Enemy // Initiative order, nil is the player
+	turnIndex int               // Position of the acting combatant in turnOrder
+
+	ItemMenuOpen bool // The "Use Item" sub-menu replaces the action menu
+	SelectedItem int  // Position in the consumables listed by the sub-menu
+
 	Styles CHudStyles
 }
 
+// baseActions are the actions available without a weapon
+var baseActions = []string{"Attack", "Defend", "Use Item", "Run"}
+
+// SkillAction is an attack of the equipped weapon or an implant ability listed in the action menu
+type SkillAction struct {
+	Action   string // Action name handled by the combat system
+	Name     string // Localization key
+	Cooldown int    // Turns left before the skill can be used again
+}
+
 type CHudStyles struct {
 	Container        lipgloss.Style
 	TopHealthBar     lipgloss.Style
@@ -146,18 +166,93 @@ func NewCombatHud(renderer engine.Renderer, locManager *engine.LocalizationManag
 		LocManager:       locManager,
 		History:          NewCombatHistory(50), // Keep last 50 actions
 		SelectedAction:   0,
-		AvailableActions: []string{"Attack", "Defend", "Use Item", "Run"},
+		AvailableActions: append([]string(nil), baseActions...),
 		ShowHistory:      false,
 		Styles:           DefaultCHudStyles(),
 	}
 }
 
-// SetCombatants sets the player and enemy for the combat
-func (cui *CombatHud) SetCombatants(player *types.Player, enemy *entities.Enemy) {
+// SetCombatants sets the player and the enemies of the combat, the first enemy is targeted
+func (cui *CombatHud) SetCombatants(player *types.Player, enemies []*entities.Enemy) {
 	cui.Player = player
-	cui.Enemy = enemy
+	cui.Enemies = enemies
+	cui.Enemy = nil
+	if len(enemies) > 0 {
+		cui.Enemy = enemies[0]
+	}
+	cui.turnOrder = nil
 	cui.History.Clear()
 	cui.SelectedAction = 0
+	cui.CloseItemMenu()
+}
+
+// SetTarget marks the enemy targeted by the player
+func (cui *CombatHud) SetTarget(enemy *entities.Enemy) {
+	cui.Enemy = enemy
+}
+
+// SetTurnOrder shows the initiative order and who is acting
+func (cui *CombatHud) SetTurnOrder(order []*entities.Enemy, index int) {
+	cui.turnOrder = order
+	cui.turnIndex = index
+}
+
+// SetSkillActions lists the weapon attacks and implant abilities before the base actions, keeping the cursor in range
+func (cui *CombatHud) SetSkillActions(actions []SkillAction) {
+	cui.skillActions = make(map[string]SkillAction, len(actions))
+	cui.AvailableActions = make([]string, 0, len(actions)+len(baseActions))
+	for _, action := range actions {
+		cui.skillActions[action.Action] = action
+		cui.AvailableActions = append(cui.AvailableActions, action.Action)
+	}
+	cui.AvailableActions = append(cui.AvailableActions, baseActions...)
+	cui.SelectedAction = min(cui.SelectedAction, len(cui.AvailableActions)-1)
+}
+
+// ConsumableSlots returns the inventory indexes of the player's consumables
+func (cui *CombatHud) ConsumableSlots() []int {
+	if cui.Player == nil {
+		return nil
+	}
+
+	var slots []int
+	for i, item := range cui.Player.Inventory {
+		if item.Type == types.Consumable {
+			slots = append(slots, i)
+		}
+	}
+	return slots
+}
+
+// OpenItemMenu opens the item sub-menu, it stays closed when the player has no consumable
+func (cui *CombatHud) OpenItemMenu() bool {
+	if len(cui.ConsumableSlots()) == 0 {
+		return false
+	}
+	cui.ItemMenuOpen = true
+	cui.SelectedItem = 0
+	return true
+}
+
+// CloseItemMenu returns to the action menu
+func (cui *CombatHud) CloseItemMenu() {
+	cui.ItemMenuOpen = false
+	cui.SelectedItem = 0
+}
+
+// MoveItemSelection moves the item cursor by delta, staying within the list
+func (cui *CombatHud) MoveItemSelection(delta int) {
+	count := len(cui.ConsumableSlots())
+	cui.SelectedItem = max(0, min(cui.SelectedItem+delta, count-1))
+}
+
+// SelectedInventoryIndex returns the inventory index of the highlighted consumable
+func (cui *CombatHud) SelectedInventoryIndex() (int, bool) {
+	slots := cui.ConsumableSlots()
+	if cui.SelectedItem < 0 || cui.SelectedItem >= len(slots) {
+		return -1, false
+	}
+	return slots[cui.SelectedItem], true
 }
 
 // SetTurn updates the current turn state
@@ -168,7 +263,7 @@ func (cui *CombatHud) SetTurn(turn types.CombatState) {
 // AddAction adds a new action to combat history using CAction struct
 func (cui *CombatHud) AddAction(actor, actionType, target string, damage int, message string) {
 	action := CAction{
-		Timestamp:  time.Now(),
+		Timestamp:  engine.GetGlobalClock().Now(),
 		Actor:      actor,
 		ActionType: actionType,
 		Target:     target,
@@ -178,6 +273,19 @@ func (cui *CombatHud) AddAction(actor, actionType, target string, damage int, me
 	cui.History.AddAction(action)
 }
 
+// AddAttack adds a resolved attack to the combat history
+func (cui *CombatHud) AddAttack(actor, actionType, target string, result types.AttackResult, message string) {
+	cui.History.AddAction(CAction{
+		Timestamp:  engine.GetGlobalClock().Now(),
+		Actor:      actor,
+		ActionType: actionType,
+		Target:     target,
+		Damage:     result.Damage,
+		Message:    message,
+		Result:     &result,
+	})
+}
+
 // UpdateState updates the combat UI state
 func (cui *CombatHud) UpdateState(turn types.CombatState) {
 	cui.CurrentTurn = turn
@@ -185,6 +293,7 @@ func (cui *CombatHud) UpdateState(turn types.CombatState) {
 	// Clear enemy reference when player is defeated for immediate UI cleanup
 	if turn == types.Dead {
 		cui.Enemy = nil
+		cui.Enemies = nil
 	}
 }
 
@@ -249,8 +358,8 @@ func (cui *CombatHud) HistoryView(maxLines int) string {
 		for _, action := range recentActions {
 			timeStr := action.Timestamp.Format("15:04:05")
 			line := fmt.Sprintf("[%s] %s", timeStr, action.Message)
-			if action.Damage > 0 {
-				line += fmt.Sprintf(" (-%d)", action.Damage)
+			if tags := cui.attackTags(action); len(tags) > 0 {
+				line += " (" + strings.Join(tags, ", ") + ")"
 			}
 			content += "\n" + line
 		}
@@ -264,6 +373,30 @@ func (cui *CombatHud) HistoryView(maxLines int) string {
 	return historyStyle.Render(content)
 }
 
+// attackTags returns the history annotations of an action: damage, miss, critical and resistances
+func (cui *CombatHud) attackTags(action CAction) []string {
+	var tags []string
+	if action.Result != nil && !action.Result.Landed() {
+		return append(tags, cui.LocManager.Text("ui.hud.history.miss"))
+	}
+	if action.Damage > 0 {
+		tags = append(tags, fmt.Sprintf("-%d", action.Damage))
+	}
+	if action.Result == nil {
+		return tags
+	}
+
+	if action.Result.Outcome == types.AttackCritical {
+		tags = append(tags, cui.LocManager.Text("ui.hud.history.critical"))
+	}
+	if action.Result.Resisted > 0 {
+		tags = append(tags, cui.LocManager.Text("ui.hud.history.resisted", action.Result.Resisted))
+	} else if action.Result.Resisted < 0 {
+		tags = append(tags, cui.LocManager.Text("ui.hud.history.weakness", -action.Result.Resisted))
+	}
+	return tags
+}
+
 func (cui *CombatHud) ActionMenu() string {
 	if cui.CurrentTurn != types.PlayerTurn {
 		var turnText string
@@ -280,10 +413,14 @@ func (cui *CombatHud) ActionMenu() string {
 		return cui.Styles.Text.Render(turnText)
 	}
 
-	content := cui.Styles.Text.Render(cui.LocManager.Text("ui.hud.actions.prompt") + "\n\n")
+	if cui.ItemMenuOpen {
+		return cui.ItemMenu()
+	}
+
+	content := cui.Styles.Text.Render(cui.LocManager.Text("ui.hud.actions.prompt")) + "\n\n"
 
 	for i, action := range cui.AvailableActions {
-		localized := cui.LocManager.Text("ui.hud.actions." + strings.ToLower(strings.ReplaceAll(action, " ", "_")))
+		localized := cui.actionLabel(action)
 		if i == cui.SelectedAction {
 			content += cui.Styles.SelectedAction.Render("> "+localized) + "\n"
 		} else {
@@ -291,11 +428,62 @@ func (cui *CombatHud) ActionMenu() string {
 		}
 	}
 
+	if cui.Enemy != nil && cui.aliveEnemies() > 1 {
+		content += "\n" + cui.Styles.Text.Render(cui.LocManager.Text("ui.hud.actions.target", cui.Enemy.Name))
+	}
 	content += "\n" + cui.Styles.Text.Render(cui.LocManager.Text("ui.hud.actions.navigate"))
 	return cui.Styles.Container.Render(content)
 }
 
-func (cui *CombatHud) InfoView(playerHealthBar string, enemyHealthBar string) string {
+// aliveEnemies counts the enemies of the fight still standing
+func (cui *CombatHud) aliveEnemies() int {
+	count := 0
+	for _, enemy := range cui.Enemies {
+		if enemy.IsAlive {
+			count++
+		}
+	}
+	return count
+}
+
+// actionLabel returns the localized menu entry of an action, skills show their cooldown
+func (cui *CombatHud) actionLabel(action string) string {
+	skill, ok := cui.skillActions[action]
+	if !ok {
+		return cui.LocManager.Text("ui.hud.actions." + strings.ToLower(strings.ReplaceAll(action, " ", "_")))
+	}
+
+	label := cui.LocManager.Text(skill.Name)
+	if skill.Cooldown > 0 {
+		label += " " + cui.LocManager.Text("ui.combat.cooldown", skill.Cooldown)
+	}
+	return label
+}
+
+// ItemMenu lists the player's consumables with their stack counts and the selected item description
+func (cui *CombatHud) ItemMenu() string {
+	content := cui.Styles.Text.Render(cui.LocManager.Text("ui.combat.item_prompt")) + "\n\n"
+
+	slots := cui.ConsumableSlots()
+	for i, slot := range slots {
+		item := cui.Player.Inventory[slot]
+		line := fmt.Sprintf("%s x%d", cui.LocManager.Text(item.Name), item.Count())
+		if i == cui.SelectedItem {
+			content += cui.Styles.SelectedAction.Render("> "+line) + "\n"
+		} else {
+			content += cui.Styles.UnselectedAction.Render("  "+line) + "\n"
+		}
+	}
+
+	if index, ok := cui.SelectedInventoryIndex(); ok {
+		content += "\n" + cui.Styles.History.Render(cui.LocManager.Text(cui.Player.Inventory[index].Description))
+	}
+
+	content += "\n" + cui.Styles.Text.Render(cui.LocManager.Text("ui.combat.item_back"))
+	return cui.Styles.Container.Render(content)
+}
+
+func (cui *CombatHud) InfoView(playerHealthBar string) string {
 
 	// Text Fields
 	playerHealthText := fmt.Sprintf("%s: %d/%d", cui.LocManager.Text("ui.hud.health"), cui.Player.Stats.CurrentHP, cui.Player.Stats.MaxHP)
@@ -305,24 +493,71 @@ func (cui *CombatHud) InfoView(playerHealthBar string, enemyHealthBar string) st
 		cui.Styles.TopHealthBar.Render(cui.Player.Name),
 		cui.Styles.Text.Render(playerHealthText),
 		cui.Styles.HealthBar.Render(playerHealthBar))
+	if len(cui.Player.Statuses) > 0 {
+		playerContent += "\n" + cui.Styles.Text.Render(cui.Player.Statuses.Icons())
+	}
+	if order := cui.turnOrderText(); order != "" {
+		playerContent += "\n\n" + cui.Styles.Text.Render(order)
+	}
 	playerBox := cui.Styles.Container.Render(playerContent)
 
-	// Only show enemy info if combat is still active (not victory/defeat), enemy health bar is provided, and enemy exists
-	if cui.CurrentTurn == types.Victory || cui.CurrentTurn == types.Dead || enemyHealthBar == "" || cui.Enemy == nil {
+	// Only show enemy info while combat is still active (not victory/defeat)
+	if cui.CurrentTurn == types.Victory || cui.CurrentTurn == types.Dead || len(cui.Enemies) == 0 {
 		// Just show player info during victory/defeat screen or when no enemy data is provided
 		return playerBox
 	}
 
-	// Create enemy info box for active combat
-	enemyHealthText := fmt.Sprintf("%s: %d/%d", cui.LocManager.Text("ui.hud.health"), cui.Enemy.CurrentHP, cui.Enemy.MaxHP)
-	enemyContent := fmt.Sprintf("%s\n%s\n%s",
-		cui.Styles.TopEnemyBar.Render(cui.Enemy.Name),
-		cui.Styles.Text.Render(enemyHealthText),
-		cui.Styles.EnemyBar.Render(enemyHealthBar))
-	enemyBox := cui.Styles.Container.Render(enemyContent)
-
 	// Combine both boxes horizontally for compact layout
-	return lipgloss.JoinHorizontal(lipgloss.Top, playerBox, enemyBox)
+	return lipgloss.JoinHorizontal(lipgloss.Top, playerBox, cui.EnemiesView())
+}
+
+// EnemiesView lists the health bar of every enemy of the fight, the targeted one marked
+func (cui *CombatHud) EnemiesView() string {
+	blocks := make([]string, 0, len(cui.Enemies))
+	for _, enemy := range cui.Enemies {
+		marker := "  "
+		if enemy == cui.Enemy {
+			marker = "▶ "
+		}
+		if !enemy.IsAlive {
+			blocks = append(blocks, cui.Styles.UnselectedAction.Render(marker+enemy.Name+" ("+cui.LocManager.Text("ui.combat.down")+")"))
+			continue
+		}
+
+		name := marker + enemy.Name
+		if len(enemy.Statuses) > 0 {
+			name += " " + enemy.Statuses.Icons()
+		}
+		healthText := fmt.Sprintf("%s: %d/%d", cui.LocManager.Text("ui.hud.health"), enemy.CurrentHP, enemy.MaxHP)
+		blocks = append(blocks, fmt.Sprintf("%s\n%s\n%s",
+			cui.Styles.TopEnemyBar.Render(name),
+			cui.Styles.Text.Render("  "+healthText),
+			cui.Styles.EnemyBar.Render("  "+cui.EHealthBar(enemy.CurrentHP, enemy.MaxHP, 20, enemy))))
+	}
+	return cui.Styles.Container.Render(strings.Join(blocks, "\n"))
+}
+
+// turnOrderText returns the initiative order with the acting combatant in brackets
+func (cui *CombatHud) turnOrderText() string {
+	if len(cui.turnOrder) < 2 || cui.Player == nil {
+		return ""
+	}
+
+	names := make([]string, 0, len(cui.turnOrder))
+	for i, enemy := range cui.turnOrder {
+		name := cui.Player.Name
+		if enemy != nil {
+			if !enemy.IsAlive {
+				continue
+			}
+			name = enemy.Name
+		}
+		if i == cui.turnIndex {
+			name = "[" + name + "]"
+		}
+		names = append(names, name)
+	}
+	return cui.LocManager.Text("ui.combat.turn_order", strings.Join(names, " › "))
 }
 
 func (cui *CombatHud) View() string {
@@ -333,14 +568,8 @@ func (cui *CombatHud) View() string {
 	// Always calculate player health bar
 	playerHealthBar := cui.PHealthBar(cui.Player.Stats.CurrentHP, cui.Player.Stats.MaxHP, 20, cui.Player)
 
-	// Only calculate enemy health bar if combat is still active (not victory/defeat) and enemy exists
-	var enemyHealthBar string
-	if cui.CurrentTurn != types.Victory && cui.CurrentTurn != types.Dead && cui.Enemy != nil {
-		enemyHealthBar = cui.EHealthBar(cui.Enemy.CurrentHP, cui.Enemy.MaxHP, 20, cui.Enemy)
-	}
-
 	// Get UI components
-	infoView := cui.InfoView(playerHealthBar, enemyHealthBar)
+	infoView := cui.InfoView(playerHealthBar)
 	actionMenu := cui.ActionMenu()
 	history := cui.HistoryView(cui.History.MaxActions)
 
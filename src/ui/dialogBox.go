@@ -18,6 +18,8 @@ type DialogBox struct {
 	textIndex   int
 	isComplete  bool
 	showCursor  bool
+	choices     []string // Answers the player picks from once the text is complete
+	choice      int
 	styles      DialogBoxStyles
 }
 
@@ -27,6 +29,8 @@ type DialogBoxStyles struct {
 	Content     lipgloss.Style
 	Speaker     lipgloss.Style
 	Background  lipgloss.Style
+	Choice      lipgloss.Style
+	Selected    lipgloss.Style
 }
 
 // DefaultDialogBoxStyles returns the default dialog box styling
@@ -43,6 +47,11 @@ func DefaultDialogBoxStyles() DialogBoxStyles {
 			Bold(true),
 		Background: lipgloss.NewStyle().
 			Background(lipgloss.Color("0")),
+		Choice: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("250")),
+		Selected: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("213")).
+			Bold(true),
 	}
 }
 
@@ -72,6 +81,8 @@ func (d *DialogBox) Show(content, speaker string, x, y int) {
 	d.textIndex = 0
 	d.isComplete = false
 	d.showCursor = true
+	d.choices = nil
+	d.choice = 0
 }
 
 // ShowCentered displays the dialog box centered on screen
@@ -86,6 +97,19 @@ func (d *DialogBox) ShowCentered(content, speaker string, screenWidth, screenHei
 	d.textIndex = 0
 	d.isComplete = false
 	d.showCursor = true
+	d.choices = nil
+	d.choice = 0
+}
+
+// SetChoices lists the answers shown under the text, the first one selected
+func (d *DialogBox) SetChoices(choices []string) {
+	d.choices = choices
+	d.choice = 0
+}
+
+// SelectedChoice returns the index of the selected answer
+func (d *DialogBox) SelectedChoice() int {
+	return d.choice
 }
 
 // Hide hides the dialog box
@@ -105,8 +129,8 @@ func (d *DialogBox) IsTextComplete() bool {
 
 // AdvanceText advances the typewriter effect or marks as complete
 func (d *DialogBox) AdvanceText() {
-	if d.textIndex < len(d.content) {
-		d.textIndex = len(d.content)
+	if length := len([]rune(d.content)); d.textIndex < length {
+		d.textIndex = length
 		d.isComplete = true
 	}
 }
@@ -124,12 +148,21 @@ func (d *DialogBox) Update(msg engine.Msg) (*DialogBox, engine.Cmd) {
 			if !d.isComplete {
 				d.AdvanceText()
 			}
+		case '↑':
+			if d.isComplete && d.choice > 0 {
+				d.choice--
+			}
+		case '↓':
+			if d.isComplete && d.choice < len(d.choices)-1 {
+				d.choice++
+			}
 		}
 	case engine.TickMsg:
 		// Typewriter effect
-		if d.textIndex < len(d.content) && !d.isComplete {
+		// Count runes so accented letters are never cut in half
+		if length := len([]rune(d.content)); d.textIndex < length && !d.isComplete {
 			d.textIndex++
-			if d.textIndex >= len(d.content) {
+			if d.textIndex >= length {
 				d.isComplete = true
 			}
 		}
@@ -181,8 +214,8 @@ func (d *DialogBox) Render() string {
 
 	// Get displayed text (with typewriter effect)
 	displayText := d.content
-	if d.textIndex < len(d.content) {
-		displayText = d.content[:d.textIndex]
+	if runes := []rune(d.content); d.textIndex < len(runes) {
+		displayText = string(runes[:d.textIndex])
 	}
 
 	// Add cursor if text is complete and visible
@@ -201,6 +234,19 @@ func (d *DialogBox) Render() string {
 	}
 	content.WriteString(d.styles.Content.Render(wrappedText))
 
+	// Answers appear once the text is fully typed
+	if d.isComplete && len(d.choices) > 0 {
+		content.WriteString("\n")
+		for i, choice := range d.choices {
+			content.WriteString("\n")
+			if i == d.choice {
+				content.WriteString(d.styles.Selected.Render("▶ " + choice))
+			} else {
+				content.WriteString(d.styles.Choice.Render("  " + choice))
+			}
+		}
+	}
+
 	// Apply border and return
 	return d.styles.Border.
 		Width(d.width).
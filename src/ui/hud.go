@@ -25,6 +25,7 @@ type HUD struct {
 	stageID         int
 	worldName       string
 	stageName       string
+	credits         int
 }
 
 // HUDStyles contains styling for the HUD
@@ -97,6 +98,11 @@ func (h *HUD) SetPlayerStats(health, maxHealth, level, exp, expToNext int, world
 	h.stageID = stageID
 }
 
+// SetCredits updates the wallet displayed in the HUD
+func (h *HUD) SetCredits(credits int) {
+	h.credits = credits
+}
+
 // SetLocation updates the world and stage names displayed in the HUD
 func (h *HUD) SetLocation(worldName, stageName string) {
 	h.worldName = worldName
@@ -156,7 +162,8 @@ func (h *HUD) View() string {
 	// Format the HUD content
 	healthText := fmt.Sprintf("%s: %d/%d", locManager.Text("ui.hud.health"), h.playerHealth, h.playerMaxHealth)
 	expText := fmt.Sprintf("%s: %d/%d", locManager.Text("ui.hud.experience"), h.playerExp, h.expToNextLevel)
-	levelText := fmt.Sprintf("%s %d", locManager.Text("ui.hud.level"), h.playerLevel)
+	levelText := fmt.Sprintf("%s %d  %s", locManager.Text("ui.hud.level"), h.playerLevel,
+		locManager.Text("ui.hud.currency", h.credits))
 
 	// Build aligned World/Stage lines so their text starts at the same column
 	// Use localized names as primary source
@@ -0,0 +1,292 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/types"
+)
+
+// allItems is the tab listing every item whatever its type
+const allItems types.ItemType = -1
+
+// inventoryTabNames are the localization keys of the category tabs
+var inventoryTabNames = map[types.ItemType]string{
+	allItems:         "ui.inventory.all",
+	types.Consumable: "ui.inventory.consumables",
+	types.Weapon:     "ui.inventory.weapons",
+	types.Upgrade:    "ui.inventory.implants",
+	types.Utility:    "ui.inventory.utilities",
+	types.Boost:      "ui.inventory.boosts",
+	types.Armor:      "ui.inventory.armors",
+}
+
+// InventoryMenu lists the player items by category with the details of the selected one
+type InventoryMenu struct {
+	Title    string
+	Items    []types.Item // Player items, in inventory order
+	Weapon   *types.Item  // Equipped weapon, nil when bare-handed
+	Capacity int          // Inventory slots of the player
+	Order    string       // Localization key of the last sort order
+	Message  string       // Feedback of the last action
+	Styles   InventoryMenuStyles
+	Loc      *engine.LocalizationManager
+	tab      int
+	selected int // Position of the selection in the active tab
+	width    int
+	height   int
+}
+
+type InventoryMenuStyles struct {
+	Title       lipgloss.Style
+	Tab         lipgloss.Style
+	ActiveTab   lipgloss.Style
+	Selected    lipgloss.Style
+	Normal      lipgloss.Style
+	Description lipgloss.Style
+	Stats       lipgloss.Style
+	Sidebar     lipgloss.Style
+}
+
+func DefaultInventoryMenuStyles() InventoryMenuStyles {
+	return InventoryMenuStyles{
+		Title: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#FAFAFA")).
+			Background(lipgloss.Color("#7D56F4")).
+			Padding(0, 1).
+			MarginBottom(1),
+		Tab: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#8A8A9E")),
+		ActiveTab: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#7D56F4")),
+		Selected: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#EE6FF8")).
+			Background(lipgloss.Color("#654EA3")).
+			Padding(0, 1),
+		Normal: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FAFAFA")).
+			Padding(0, 1),
+		Description: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#C0C0C0")).
+			Padding(1, 1).
+			MarginTop(1),
+		Stats: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FAFAFA")).
+			Padding(0, 1),
+		Sidebar: lipgloss.NewStyle().
+			Background(lipgloss.Color("#1F1F2E")).
+			Padding(1, 1),
+	}
+}
+
+func NewInventoryMenu(title string, loc *engine.LocalizationManager, styles ...InventoryMenuStyles) InventoryMenu {
+	menuStyles := DefaultInventoryMenuStyles()
+	if len(styles) > 0 {
+		menuStyles = styles[0]
+	}
+
+	return InventoryMenu{
+		Title:  title,
+		Styles: menuStyles,
+		Loc:    loc,
+	}
+}
+
+// SetItems replaces the player items, keeping the tab and the selection in range
+func (m *InventoryMenu) SetItems(items []types.Item, weapon *types.Item, capacity int) {
+	m.Items = items
+	m.Weapon = weapon
+	m.Capacity = capacity
+	if m.tab >= len(m.tabs()) {
+		m.tab = 0
+	}
+	if m.selected >= len(m.visible()) {
+		m.selected = max(len(m.visible())-1, 0)
+	}
+}
+
+// Reset goes back to the first tab and item, used when the inventory is opened
+func (m *InventoryMenu) Reset() {
+	m.tab = 0
+	m.selected = 0
+	m.Message = ""
+}
+
+// tabs returns the tab of every item and the ones of the item types the player carries
+func (m InventoryMenu) tabs() []types.ItemType {
+	tabs := []types.ItemType{allItems}
+	for _, itemType := range []types.ItemType{types.Consumable, types.Weapon, types.Upgrade, types.Utility, types.Boost, types.Armor} {
+		for _, item := range m.Items {
+			if item.Type == itemType {
+				tabs = append(tabs, itemType)
+				break
+			}
+		}
+	}
+	return tabs
+}
+
+// visible returns the inventory index of every item of the active tab
+func (m InventoryMenu) visible() []int {
+	tab := m.tabs()[m.tab]
+	var indexes []int
+	for i, item := range m.Items {
+		if tab == allItems || item.Type == tab {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// SelectedIndex returns the inventory index of the selected item, false when the tab is empty
+func (m InventoryMenu) SelectedIndex() (int, bool) {
+	visible := m.visible()
+	if m.selected < 0 || m.selected >= len(visible) {
+		return -1, false
+	}
+	return visible[m.selected], true
+}
+
+// switchTab moves to the next or previous tab, the selection goes back to the top
+func (m *InventoryMenu) switchTab(step int) {
+	count := len(m.tabs())
+	m.tab = (m.tab + step + count) % count
+	m.selected = 0
+}
+
+func (m InventoryMenu) Update(msg engine.Msg) (InventoryMenu, engine.Msg) {
+	switch msg := msg.(type) {
+	case engine.SizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	case engine.KeyMsg:
+		switch msg.Rune {
+		case '↓':
+			if m.selected < len(m.visible())-1 {
+				m.selected++
+			}
+		case '↑':
+			if m.selected > 0 {
+				m.selected--
+			}
+		case '→', engine.RuneTab:
+			m.switchTab(1)
+		case '←':
+			m.switchTab(-1)
+		}
+	}
+	return m, nil
+}
+
+func (m InventoryMenu) View() string {
+	if m.width == 0 || m.height == 0 {
+		return ""
+	}
+
+	var menuItems []string
+	menuItems = append(menuItems, m.Styles.Title.Render(m.Loc.Text(m.Title)))
+	menuItems = append(menuItems, m.Styles.Normal.Render(m.Loc.Text("ui.inventory.slots", len(m.Items), m.Capacity)))
+	weapon := m.Loc.Text("ui.inventory.bare_hands")
+	if m.Weapon != nil {
+		weapon = m.Loc.Text(m.Weapon.Name)
+	}
+	menuItems = append(menuItems, m.Styles.Normal.Render(m.Loc.Text("ui.hud.equip", weapon)))
+	menuItems = append(menuItems, m.Styles.Normal.Render(m.renderTabs()))
+
+	visible := m.visible()
+	if len(visible) == 0 {
+		menuItems = append(menuItems, m.Styles.Normal.Render("  "+m.Loc.Text("ui.inventory.empty")))
+	}
+	for i, index := range visible {
+		item := m.Items[index]
+		itemName := m.Loc.Text(item.Name)
+		if item.Count() > 1 {
+			itemName = fmt.Sprintf("%s x%d", itemName, item.Count())
+		}
+		if i == m.selected {
+			menuItems = append(menuItems, m.Styles.Selected.Render("▶ "+itemName))
+		} else {
+			menuItems = append(menuItems, m.Styles.Normal.Render("  "+itemName))
+		}
+	}
+
+	if m.Message != "" {
+		menuItems = append(menuItems, m.Styles.Description.Render(m.Message))
+	}
+	hint := m.Loc.Text("ui.inventory.hint")
+	if m.Order != "" {
+		hint += "\n" + m.Loc.Text("ui.inventory.sorted", m.Loc.Text(m.Order))
+	}
+	menuItems = append(menuItems, m.Styles.Description.Render(hint))
+
+	leftColumn := lipgloss.JoinVertical(lipgloss.Left, menuItems...)
+
+	gapW := 2
+	leftW := min(max(m.width*2/5, 18), m.width)
+	leftMargin := (m.width - leftW) / 2
+
+	rightW := 0
+	index, hasSelection := m.SelectedIndex()
+	if availableRight := m.width - (leftMargin + leftW) - gapW; hasSelection && availableRight >= 20 {
+		rightW = availableRight
+	}
+
+	targetH := max(m.height, 1)
+	left := lipgloss.Place(leftW, targetH, lipgloss.Left, lipgloss.Center, leftColumn)
+	spacer := lipgloss.NewStyle().Width(leftMargin).Height(targetH).Render("")
+	gap := lipgloss.NewStyle().Width(gapW).Height(targetH).Render("")
+
+	content := lipgloss.JoinHorizontal(lipgloss.Top, spacer, left)
+	if rightW > 0 {
+		right := lipgloss.Place(rightW, targetH, lipgloss.Left, lipgloss.Center, m.renderSidebar(m.Items[index], rightW))
+		content = lipgloss.JoinHorizontal(lipgloss.Top, spacer, left, gap, right)
+	}
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Left, lipgloss.Center, content)
+}
+
+// renderTabs shows the category tabs, the active one in brackets
+func (m InventoryMenu) renderTabs() string {
+	var labels []string
+	for i, tab := range m.tabs() {
+		label := m.Loc.Text(inventoryTabNames[tab])
+		if i == m.tab {
+			labels = append(labels, m.Styles.ActiveTab.Render("["+label+"]"))
+			continue
+		}
+		labels = append(labels, m.Styles.Tab.Render(" "+label+" "))
+	}
+	return strings.Join(labels, " ")
+}
+
+// renderSidebar shows the details of an item and what Enter does with it
+func (m InventoryMenu) renderSidebar(item types.Item, width int) string {
+	blocks := []string{
+		m.Styles.Title.Render(m.Loc.Text(item.Name)),
+		m.Styles.Stats.Render(m.Loc.Text("ui.inventory.category", m.Loc.Text(inventoryTabNames[item.Type]))),
+		m.Styles.Stats.Render(m.Loc.Text("ui.inventory.quantity", item.Count())),
+		m.Styles.Stats.Render(m.Loc.Text("ui.inventory.value", item.Value)),
+	}
+	if item.Description != "" {
+		blocks = append(blocks, m.Styles.Description.Width(width-2).Render(m.Loc.Text(item.Description)))
+	}
+
+	action := ""
+	switch item.Type {
+	case types.Consumable:
+		action = "ui.inventory.action_use"
+	case types.Weapon:
+		action = "ui.inventory.action_equip"
+	}
+	if action != "" {
+		blocks = append(blocks, m.Styles.Description.Render(m.Loc.Text(action)))
+	}
+
+	inner := lipgloss.JoinVertical(lipgloss.Left, blocks...)
+	return m.Styles.Sidebar.Width(width).Render(inner)
+}
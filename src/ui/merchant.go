@@ -10,22 +10,31 @@ import (
 )
 
 type MerchantMenuOption struct {
-    Item  types.Item
-    Price int
+    Item    types.Item
+    Price   int
+    Section string // Localization key of the section title, options of a section are consecutive
+    Stock   int    // Copies left, negative when unlimited
+    Ref     [2]int // Section and entry of the option in the merchant catalog
 }
 
 type MerchantMenu struct {
-    Title    string
-    Options  []MerchantMenuOption
-    Styles   MerchantMenuStyles
-    Loc      *engine.LocalizationManager
-    selected int
-    width    int
-    height   int
+    Title       string
+    Options     []MerchantMenuOption // Merchant stock
+    SellOptions []MerchantMenuOption // Player items, in inventory order
+    Selling     bool                 // Browse SellOptions instead of Options
+    Clinic      bool                 // Implant clinic: the tabs install and remove implants
+    Credits     int                  // Player wallet
+    Message     string               // Feedback of the last trade
+    Styles      MerchantMenuStyles
+    Loc         *engine.LocalizationManager
+    selected    int
+    width       int
+    height      int
 }
 
 type MerchantMenuStyles struct {
     Title       lipgloss.Style
+    Section     lipgloss.Style
     Selected    lipgloss.Style
     Normal      lipgloss.Style
     Description lipgloss.Style
@@ -41,6 +50,10 @@ func DefaultMerchantMenuStyles() MerchantMenuStyles {
             Background(lipgloss.Color("#7D56F4")).
             Padding(0, 1).
             MarginBottom(1),
+        Section: lipgloss.NewStyle().
+            Bold(true).
+            Foreground(lipgloss.Color("#7D56F4")).
+            Padding(0, 1),
         Selected: lipgloss.NewStyle().
             Bold(true).
             Foreground(lipgloss.Color("#EE6FF8")).
@@ -88,6 +101,34 @@ func (m MerchantMenu) localize(s string) string {
     return tr
 }
 
+// current returns the options of the active mode
+func (m MerchantMenu) current() []MerchantMenuOption {
+    if m.Selling {
+        return m.SellOptions
+    }
+    return m.Options
+}
+
+// SetSelling switches between buying and selling, the selection goes back to the top
+func (m *MerchantMenu) SetSelling(selling bool) {
+    m.Selling = selling
+    m.selected = 0
+    m.Message = ""
+}
+
+// SetSellOptions replaces the player items, keeping the selection in range
+func (m *MerchantMenu) SetSellOptions(options []MerchantMenuOption) {
+    m.SellOptions = options
+    if m.Selling && m.selected >= len(options) {
+        m.selected = max(len(options)-1, 0)
+    }
+}
+
+// SelectedIndex returns the position of the selected option in the active mode
+func (m MerchantMenu) SelectedIndex() int {
+    return m.selected
+}
+
 func (m MerchantMenu) Update(msg engine.Msg) (MerchantMenu, engine.Msg) {
     switch msg := msg.(type) {
     case engine.SizeMsg:
@@ -96,7 +137,7 @@ func (m MerchantMenu) Update(msg engine.Msg) (MerchantMenu, engine.Msg) {
     case engine.KeyMsg:
         switch msg.Rune {
         case '↓':
-            if m.selected < len(m.Options)-1 {
+            if m.selected < len(m.current())-1 {
                 m.selected++
             }
         case '↑':
@@ -116,9 +157,32 @@ func (m MerchantMenu) View() string {
     var menuItems []string
 
     menuItems = append(menuItems, m.Styles.Title.Render(m.localize(m.Title)))
-    for i, option := range m.Options {
+    menuItems = append(menuItems, m.Styles.Normal.Render(m.Loc.Text("ui.merchant.credits", m.Credits)))
+    menuItems = append(menuItems, m.Styles.Normal.Render(m.renderModes()))
+
+    options := m.current()
+    if len(options) == 0 && m.Selling {
+        empty := "ui.merchant.nothing_to_sell"
+        if m.Clinic {
+            empty = "ui.merchant.no_implants"
+        }
+        menuItems = append(menuItems, m.Styles.Normal.Render("  "+m.Loc.Text(empty)))
+    }
+    section := ""
+    for i, option := range options {
+        if option.Section != section {
+            section = option.Section
+            menuItems = append(menuItems, m.Styles.Section.Render("═══ "+m.localize(section)+" ═══"))
+        }
+
         var item string
         itemName := m.localize(option.Item.Name)
+        if option.Item.Count() > 1 {
+            itemName = fmt.Sprintf("%s x%d", itemName, option.Item.Count())
+        }
+        if option.Stock == 0 && !m.Selling {
+            itemName += " (" + m.Loc.Text("ui.merchant.sold_out") + ")"
+        }
         if i == m.selected {
             item = m.Styles.Selected.Render("▶ " + itemName)
         } else {
@@ -127,10 +191,15 @@ func (m MerchantMenu) View() string {
         menuItems = append(menuItems, item)
     }
 
+    if m.Message != "" {
+        menuItems = append(menuItems, m.Styles.Description.Render(m.Message))
+    }
+    menuItems = append(menuItems, m.Styles.Description.Render(m.Loc.Text("ui.merchant.hint")))
+
     leftColumn := lipgloss.JoinVertical(lipgloss.Left, menuItems...)
 
     const minTotalForSidebar = 44
-    canTrySidebar := m.width >= minTotalForSidebar && len(m.Options) > 0
+    canTrySidebar := m.width >= minTotalForSidebar && len(options) > 0
 
     gapW := 2
     leftW := m.width * 2 / 5
@@ -166,7 +235,7 @@ func (m MerchantMenu) View() string {
 
     var content string
     if rightW > 0 {
-        rightContent := m.renderSidebar(m.Options[m.selected], rightW)
+        rightContent := m.renderSidebar(options[m.selected], rightW)
         right := lipgloss.Place(rightW, targetH, lipgloss.Left, lipgloss.Center, rightContent)
         content = lipgloss.JoinHorizontal(lipgloss.Top, spacer, left, gap, right)
     } else {
@@ -196,19 +265,40 @@ func (m MerchantMenu) renderSidebar(opt MerchantMenuOption, width int) string {
 
     descBlock := m.Styles.Description.
         Width(width).
-        Render("Type: " + itemType)
+        Render(m.Loc.Text("ui.merchant.type", itemType))
 
+    priceKey := "ui.merchant.price"
+    if m.Selling {
+        priceKey = "ui.merchant.sell_price"
+    }
     priceBlock := m.Styles.Stats.
         Width(width).
-        Render(fmt.Sprintf("Prix: %d", opt.Price))  // Utilise fmt.Sprintf !
+        Render(m.Loc.Text(priceKey, opt.Price))
 
-    inner := lipgloss.JoinVertical(lipgloss.Left, nameBlock, descBlock, priceBlock)
+    blocks := []string{nameBlock, descBlock, priceBlock}
+    if opt.Stock >= 0 && !m.Selling {
+        blocks = append(blocks, m.Styles.Stats.Width(width).Render(m.Loc.Text("ui.merchant.stock", opt.Stock)))
+    }
+    inner := lipgloss.JoinVertical(lipgloss.Left, blocks...)
     return m.Styles.Sidebar.Width(width).Render(inner)
 }
 
+// renderModes shows the buy and sell tabs, the active one in brackets
+func (m MerchantMenu) renderModes() string {
+    buy, sell := m.Loc.Text("ui.merchant.buy"), m.Loc.Text("ui.merchant.sell")
+    if m.Clinic {
+        buy, sell = m.Loc.Text("ui.merchant.install"), m.Loc.Text("ui.merchant.remove")
+    }
+    if m.Selling {
+        return fmt.Sprintf(" %s  [%s]", buy, sell)
+    }
+    return fmt.Sprintf("[%s]  %s", buy, sell)
+}
+
 func (m MerchantMenu) GetSelected() MerchantMenuOption {
-    if m.selected >= 0 && m.selected < len(m.Options) {
-        return m.Options[m.selected]
+    options := m.current()
+    if m.selected >= 0 && m.selected < len(options) {
+        return options[m.selected]
     }
     return MerchantMenuOption{}
 }
\ No newline at end of file
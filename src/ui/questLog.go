@@ -0,0 +1,237 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"projectred-rpg.com/engine"
+	"projectred-rpg.com/game/types"
+)
+
+// QuestLogEntry is a quest shown in the quest log with the progress of the player
+type QuestLogEntry struct {
+	Quest       types.Quest
+	Progress    types.QuestProgress
+	RewardItems []string // Localized names of the reward items
+}
+
+// QuestLog lists the active and completed quests with the objectives of the selected one
+type QuestLog struct {
+	Title     string
+	Active    []QuestLogEntry
+	Completed []QuestLogEntry
+	Styles    QuestLogStyles
+	Loc       *engine.LocalizationManager
+	completed bool // Whether the completed tab is shown
+	selected  int  // Position of the selection in the shown tab
+	width     int
+	height    int
+}
+
+type QuestLogStyles struct {
+	Title       lipgloss.Style
+	Tab         lipgloss.Style
+	ActiveTab   lipgloss.Style
+	Selected    lipgloss.Style
+	Normal      lipgloss.Style
+	Description lipgloss.Style
+	Done        lipgloss.Style
+	Sidebar     lipgloss.Style
+}
+
+func DefaultQuestLogStyles() QuestLogStyles {
+	return QuestLogStyles{
+		Title: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#FAFAFA")).
+			Background(lipgloss.Color("#7D56F4")).
+			Padding(0, 1).
+			MarginBottom(1),
+		Tab: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#8A8A9E")),
+		ActiveTab: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#7D56F4")),
+		Selected: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#EE6FF8")).
+			Background(lipgloss.Color("#654EA3")).
+			Padding(0, 1),
+		Normal: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FAFAFA")).
+			Padding(0, 1),
+		Description: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#C0C0C0")).
+			Padding(1, 1).
+			MarginTop(1),
+		Done: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#04B575")).
+			Padding(0, 1),
+		Sidebar: lipgloss.NewStyle().
+			Background(lipgloss.Color("#1F1F2E")).
+			Padding(1, 1),
+	}
+}
+
+func NewQuestLog(title string, loc *engine.LocalizationManager, styles ...QuestLogStyles) QuestLog {
+	logStyles := DefaultQuestLogStyles()
+	if len(styles) > 0 {
+		logStyles = styles[0]
+	}
+
+	return QuestLog{
+		Title:  title,
+		Styles: logStyles,
+		Loc:    loc,
+	}
+}
+
+// SetQuests replaces the listed quests, keeping the selection in range
+func (q *QuestLog) SetQuests(active, completed []QuestLogEntry) {
+	q.Active = active
+	q.Completed = completed
+	if q.selected >= len(q.entries()) {
+		q.selected = max(len(q.entries())-1, 0)
+	}
+}
+
+// Reset goes back to the active quests, used when the log is opened
+func (q *QuestLog) Reset() {
+	q.completed = false
+	q.selected = 0
+}
+
+// entries returns the quests of the shown tab
+func (q QuestLog) entries() []QuestLogEntry {
+	if q.completed {
+		return q.Completed
+	}
+	return q.Active
+}
+
+func (q QuestLog) Update(msg engine.Msg) (QuestLog, engine.Msg) {
+	switch msg := msg.(type) {
+	case engine.SizeMsg:
+		q.width = msg.Width
+		q.height = msg.Height
+	case engine.KeyMsg:
+		switch msg.Rune {
+		case '↓':
+			if q.selected < len(q.entries())-1 {
+				q.selected++
+			}
+		case '↑':
+			if q.selected > 0 {
+				q.selected--
+			}
+		case '→', '←', engine.RuneTab:
+			q.completed = !q.completed
+			q.selected = 0
+		}
+	}
+	return q, nil
+}
+
+func (q QuestLog) View() string {
+	if q.width == 0 || q.height == 0 {
+		return ""
+	}
+
+	var menuItems []string
+	menuItems = append(menuItems, q.Styles.Title.Render(q.Loc.Text(q.Title)))
+	menuItems = append(menuItems, q.Styles.Normal.Render(q.renderTabs()))
+
+	entries := q.entries()
+	if len(entries) == 0 {
+		menuItems = append(menuItems, q.Styles.Normal.Render("  "+q.Loc.Text("ui.quests.empty")))
+	}
+	for i, entry := range entries {
+		name := q.Loc.Text(entry.Quest.Name)
+		if i == q.selected {
+			menuItems = append(menuItems, q.Styles.Selected.Render("▶ "+name))
+		} else {
+			menuItems = append(menuItems, q.Styles.Normal.Render("  "+name))
+		}
+	}
+	menuItems = append(menuItems, q.Styles.Description.Render(q.Loc.Text("ui.quests.hint")))
+
+	leftColumn := lipgloss.JoinVertical(lipgloss.Left, menuItems...)
+
+	gapW := 2
+	leftW := min(max(q.width*2/5, 18), q.width)
+	leftMargin := (q.width - leftW) / 2
+
+	rightW := 0
+	if availableRight := q.width - (leftMargin + leftW) - gapW; len(entries) > 0 && availableRight >= 20 {
+		rightW = availableRight
+	}
+
+	targetH := max(q.height, 1)
+	left := lipgloss.Place(leftW, targetH, lipgloss.Left, lipgloss.Center, leftColumn)
+	spacer := lipgloss.NewStyle().Width(leftMargin).Height(targetH).Render("")
+	gap := lipgloss.NewStyle().Width(gapW).Height(targetH).Render("")
+
+	content := lipgloss.JoinHorizontal(lipgloss.Top, spacer, left)
+	if rightW > 0 {
+		right := lipgloss.Place(rightW, targetH, lipgloss.Left, lipgloss.Center, q.renderSidebar(entries[q.selected], rightW))
+		content = lipgloss.JoinHorizontal(lipgloss.Top, spacer, left, gap, right)
+	}
+
+	return lipgloss.Place(q.width, q.height, lipgloss.Left, lipgloss.Center, content)
+}
+
+// renderTabs shows the active and completed tabs, the shown one in brackets
+func (q QuestLog) renderTabs() string {
+	tabs := []struct {
+		label string
+		shown bool
+	}{
+		{q.Loc.Text("ui.quests.active", len(q.Active)), !q.completed},
+		{q.Loc.Text("ui.quests.completed", len(q.Completed)), q.completed},
+	}
+
+	var labels []string
+	for _, tab := range tabs {
+		if tab.shown {
+			labels = append(labels, q.Styles.ActiveTab.Render("["+tab.label+"]"))
+			continue
+		}
+		labels = append(labels, q.Styles.Tab.Render(" "+tab.label+" "))
+	}
+	return strings.Join(labels, " ")
+}
+
+// renderSidebar shows the description of a quest, the progress of its objectives and its reward
+func (q QuestLog) renderSidebar(entry QuestLogEntry, width int) string {
+	blocks := []string{q.Styles.Title.Render(q.Loc.Text(entry.Quest.Name))}
+	if entry.Quest.Description != "" {
+		blocks = append(blocks, q.Styles.Description.Width(width-2).MarginTop(0).Render(q.Loc.Text(entry.Quest.Description)))
+	}
+
+	for i, objective := range entry.Quest.Objectives {
+		progress := 0
+		if i < len(entry.Progress.Progress) {
+			progress = entry.Progress.Progress[i]
+		}
+		line := q.Loc.Text(objective.Text)
+		if objective.Needed() > 1 {
+			line = fmt.Sprintf("%s (%d/%d)", line, progress, objective.Needed())
+		}
+		if entry.Progress.Done(entry.Quest, i) {
+			blocks = append(blocks, q.Styles.Done.Render("✔ "+line))
+		} else {
+			blocks = append(blocks, q.Styles.Normal.Render("• "+line))
+		}
+	}
+
+	reward := entry.Quest.Reward
+	rewardText := q.Loc.Text("ui.quests.reward", reward.Exp, reward.Credits)
+	if len(entry.RewardItems) > 0 {
+		rewardText += "\n" + q.Loc.Text("ui.quests.reward_items", strings.Join(entry.RewardItems, ", "))
+	}
+	blocks = append(blocks, q.Styles.Description.Render(rewardText))
+
+	inner := lipgloss.JoinVertical(lipgloss.Left, blocks...)
+	return q.Styles.Sidebar.Width(width).Render(inner)
+}